@@ -0,0 +1,128 @@
+// aoc is the runner for all the daily solutions, so there is no need
+// to build and start them one by one.
+//
+// Usage: aoc run <days> [options]
+//
+//	or: aoc verify [days] [options]
+//	or: aoc submit <day> <part> [answer] [options]
+//
+// The days are a day number, a range like 3-7, a list like 1,3,5-7 or 'all'.
+// The options are the same as for the day binaries, see 'aoc help'.
+//
+// The verify command checks the answers against the known good ones
+// in the answers file, and exits with an error if any of them differ
+// or any of the days fail. The failed days don't stop the checking.
+// The examples of the tests in cmd/dayNN/testdata are always checked.
+//
+// The answers.txt in the repository starts empty on purpose: the puzzle
+// inputs are personal and are not committed, so neither are their answers.
+// Run 'aoc verify -update' with a session (or -dir) to fill it in locally.
+//
+// The submit command sends the answer to the site, or runs the solution
+// for it on the real input if not given, so -example and -part are not
+// allowed there. The same answer is never sent twice.
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+
+	_ "github.com/rawbits2010/AoC25/internal/days"
+	"github.com/rawbits2010/AoC25/internal/inputhandler"
+	"github.com/rawbits2010/AoC25/internal/solution"
+)
+
+func main() {
+
+	if len(os.Args) < 2 {
+		printUsage()
+		os.Exit(int(inputhandler.ErrorCodeParameters))
+	}
+
+	var err error
+	switch os.Args[1] {
+	case "run":
+		err = runCommand(os.Args[2:])
+	case "verify":
+		err = verifyCommand(os.Args[2:])
+	case "submit":
+		err = submitCommand(os.Args[2:])
+	case "help", "-h", "-help", "--help":
+		printUsage()
+		return
+	default:
+		err = fmt.Errorf("%w: unknown command (%s)", inputhandler.ErrorInvalidParameters, os.Args[1])
+	}
+
+	if errors.Is(err, flag.ErrHelp) {
+		printUsage()
+		return
+	}
+	if errors.Is(err, inputhandler.ErrorInvalidParameters) {
+		fmt.Printf("Error while parsing command line: %v\n\n", err)
+		printUsage()
+		os.Exit(int(inputhandler.ErrorCodeParameters))
+	}
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		os.Exit(int(inputhandler.ErrorCodeOf(err)))
+	}
+}
+
+func printUsage() {
+	fmt.Println("Usage: aoc run <days> [options]")
+	fmt.Println("   or: aoc verify [days] [options]")
+	fmt.Println("   or: aoc submit <day> <part> [answer] [options]")
+	fmt.Println("")
+	fmt.Println("run    - runs the solutions and prints the answers with the time taken")
+	fmt.Println("verify - checks the answers against the -answers file (all days by default)")
+	fmt.Println("submit - sends the answer, or the one the solution gives, to the site")
+	fmt.Println("days   - a day number, a range like 3-7, a list like 1,3,5-7 or 'all'")
+	fmt.Println("")
+	fmt.Println("The inputs are downloaded, or read from the -dir directory.")
+	fmt.Println("A single day can have its input given like for the day binaries.")
+	fmt.Println("")
+	inputhandler.PrintOptions()
+}
+
+// parseDays returns the days in the order given, without duplicates.
+func parseDays(spec string) ([]int, error) {
+
+	if spec == "all" {
+		return solution.Days(), nil
+	}
+
+	var days []int
+	for _, item := range strings.Split(spec, ",") {
+
+		firstStr, lastStr, isRange := strings.Cut(item, "-")
+
+		first, err := strconv.Atoi(firstStr)
+		if err != nil {
+			return nil, fmt.Errorf("%w: invalid day (%s)", inputhandler.ErrorInvalidParameters, item)
+		}
+		last := first
+		if isRange {
+			last, err = strconv.Atoi(lastStr)
+			if err != nil || last < first {
+				return nil, fmt.Errorf("%w: invalid range of days (%s)", inputhandler.ErrorInvalidParameters, item)
+			}
+		}
+
+		for day := first; day <= last; day++ {
+			if _, ok := solution.Get(day); !ok {
+				return nil, fmt.Errorf("%w: there is no solution for day %d", inputhandler.ErrorInvalidParameters, day)
+			}
+			if !slices.Contains(days, day) {
+				days = append(days, day)
+			}
+		}
+	}
+
+	return days, nil
+}
@@ -0,0 +1,119 @@
+package main
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"github.com/rawbits2010/AoC25/internal/answers"
+
+	"github.com/rawbits2010/AoC25/internal/inputhandler"
+	"github.com/rawbits2010/AoC25/internal/solution"
+)
+
+func TestParseDays(t *testing.T) {
+
+	cases := map[string][]int{
+		"5":       {5},
+		"3-7":     {3, 4, 5, 6, 7},
+		"9,1-2,1": {9, 1, 2},
+		"all":     solution.Days(),
+	}
+
+	for spec, expected := range cases {
+		days, err := parseDays(spec)
+		if err != nil {
+			t.Errorf("%s: %v", spec, err)
+			continue
+		}
+		if !slices.Equal(days, expected) {
+			t.Errorf("%s: expected %v, got %v", spec, expected, days)
+		}
+	}
+
+	for _, spec := range []string{"", "x", "7-3", "1-", "99", "1,,2"} {
+		if _, err := parseDays(spec); !errors.Is(err, inputhandler.ErrorInvalidParameters) {
+			t.Errorf("%s: expected ErrorInvalidParameters, got %v", spec, err)
+		}
+	}
+}
+
+func TestVerifyKeepsGoing(t *testing.T) {
+
+	// there is no input for day 2, so it fails before day 3 is checked
+	dir := t.TempDir()
+	example, err := os.ReadFile(filepath.Join("..", "day03", "testdata", "example1.txt"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "day03_example.txt"), example, 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	answersPath := filepath.Join(dir, "answers.txt")
+	if err := os.WriteFile(answersPath, []byte("2 example 1 2\n3 example - -\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	err = verifyCommand([]string{"-answers", answersPath, "-dir", dir})
+	if !errors.Is(err, ErrFailed) {
+		t.Fatalf("expected ErrFailed, got %v", err)
+	}
+
+	// day 3 is still updated, day 2 is left as it was
+	err = verifyCommand([]string{"-answers", answersPath, "-dir", dir, "-update"})
+	if !errors.Is(err, ErrFailed) {
+		t.Fatalf("expected ErrFailed, got %v", err)
+	}
+
+	got, err := answers.Load(answersPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []answers.Answer{
+		{Day: 2, Input: answers.Example, Parts: [2]string{"1", "2"}},
+		{Day: 3, Input: answers.Example, Parts: [2]string{"357", "3121910778619"}},
+	}
+	if !slices.Equal(got, expected) {
+		t.Errorf("expected %v, got %v", expected, got)
+	}
+}
+
+func TestSubmitRejectsExample(t *testing.T) {
+
+	t.Cleanup(func() { inputhandler.ParseOptions(nil) })
+
+	// these are checked before anything is solved or sent
+	for _, args := range [][]string{
+		{"8", "1", "--example"},
+		{"8", "1", "1234", "-example"},
+		{"8", "2", "--part", "1"},
+	} {
+		if err := submitCommand(args); !errors.Is(err, inputhandler.ErrorInvalidParameters) {
+			t.Errorf("%v: expected ErrorInvalidParameters, got %v", args, err)
+		}
+	}
+}
+
+func TestLoadTestdataAnswers(t *testing.T) {
+
+	// the testdata is not used with -dir
+	if _, err := inputhandler.ParseOptions(nil); err != nil {
+		t.Fatal(err)
+	}
+
+	// the answers file has its own for day 3
+	known := []answers.Answer{{Day: 3, Input: answers.Example, Parts: [2]string{"1", "2"}}}
+
+	examples, err := loadTestdataAnswers([]int{1, 3}, known)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []answers.Answer{{Day: 1, Input: answers.Example, Parts: [2]string{"3", "6"}}}
+	if !slices.Equal(examples, expected) {
+		t.Errorf("expected %v, got %v", expected, examples)
+	}
+}
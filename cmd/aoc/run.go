@@ -0,0 +1,149 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"text/tabwriter"
+	"time"
+
+	"github.com/rawbits2010/AoC25/internal/inputhandler"
+	"github.com/rawbits2010/AoC25/internal/solution"
+)
+
+var inputDir = inputhandler.Options.String("dir", "", "directory of the puzzle inputs named like day05.txt, or day05_example.txt with --example (default is to download them, and the examples of the tests)")
+
+// ErrFailed is returned when some of the days couldn't be solved.
+var ErrFailed = errors.New("some of the solutions failed")
+
+// dayResult is a row of the results table.
+type dayResult struct {
+	day     int
+	results []solution.Result
+	err     error
+}
+
+func runCommand(args []string) error {
+
+	if len(args) == 0 {
+		return fmt.Errorf("%w: which days to run?", inputhandler.ErrorInvalidParameters)
+	}
+
+	days, err := parseDays(args[0])
+	if err != nil {
+		return err
+	}
+
+	config, err := inputhandler.ParseOptions(args[1:])
+	if err != nil {
+		return err
+	}
+
+	if config.Method == inputhandler.InputCache {
+		return inputhandler.RunCacheCommand(inputhandler.NewCache(config.CacheDir), config.Value)
+	}
+	if config.Method != inputhandler.InputInvalid && len(days) > 1 {
+		return fmt.Errorf("%w: an input can only be given when running a single day", inputhandler.ErrorInvalidParameters)
+	}
+
+	rows := make([]dayResult, 0, len(days))
+	for _, day := range days {
+
+		dayConfig := config
+		if dayConfig.Method == inputhandler.InputInvalid {
+			dayConfig, err = inputConfig(day, inputhandler.Example())
+			if err != nil {
+				return err
+			}
+		}
+
+		rows = append(rows, runDay(day, dayConfig))
+	}
+
+	return printResults(rows)
+}
+
+// inputConfig is where the input of the day is when it's not given on the commandline.
+func inputConfig(day int, example bool) (inputhandler.Config, error) {
+
+	if example && *inputDir == "" {
+		return inputhandler.OptionsConfig(inputhandler.InputFile, inputhandler.ExamplePath(day))
+	}
+	if *inputDir == "" {
+		return inputhandler.OptionsConfig(inputhandler.InputWebpage, inputhandler.InputURL(day))
+	}
+
+	name := fmt.Sprintf("day%02d.txt", day)
+	if example {
+		name = fmt.Sprintf("day%02d_example.txt", day)
+	}
+	return inputhandler.OptionsConfig(inputhandler.InputFile, filepath.Join(*inputDir, name))
+}
+
+func runDay(day int, config inputhandler.Config) dayResult {
+
+	s, _ := solution.Get(day)
+
+	lines, err := inputhandler.Read(config)
+	if err != nil {
+		return dayResult{day: day, err: err}
+	}
+
+	return dayResult{day: day, results: solution.Run(s, lines, inputhandler.Part())}
+}
+
+// printResults prints the table of answers and timings, then the errors.
+// Returns ErrFailed if there was any.
+func printResults(rows []dayResult) error {
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "Day\tPart 1\tTime\tPart 2\tTime")
+
+	var total time.Duration
+	var errs []error
+	for _, row := range rows {
+
+		if row.err != nil {
+			fmt.Fprintf(w, "%d\terror\t\terror\t\n", row.day)
+			errs = append(errs, fmt.Errorf("day %d: %w", row.day, row.err))
+			continue
+		}
+
+		// both columns are there even if only one part was run
+		cells := [2]string{"-\t", "-\t"}
+		for _, result := range row.results {
+			cells[result.Part-1] = fmt.Sprintf("%s\t%s", result, formatDuration(result.Duration))
+			total += result.Duration
+			if result.Err != nil && !result.Skipped() {
+				errs = append(errs, fmt.Errorf("day %d part %d: %w", row.day, result.Part, result.Err))
+			}
+		}
+		fmt.Fprintf(w, "%d\t%s\t%s\n", row.day, cells[0], cells[1])
+	}
+
+	fmt.Fprintf(w, "Total\t\t%s\n", formatDuration(total))
+	w.Flush()
+
+	if len(errs) == 0 {
+		return nil
+	}
+
+	fmt.Println()
+	for _, err := range errs {
+		fmt.Printf("Error - %v\n", err)
+	}
+	return &inputhandler.Error{Code: inputhandler.ErrorCodeProcessing, Err: ErrFailed}
+}
+
+// formatDuration rounds the time to be easy to read in the table.
+func formatDuration(d time.Duration) string {
+	switch {
+	case d >= time.Second:
+		return d.Round(time.Millisecond).String()
+	case d >= time.Millisecond:
+		return d.Round(time.Microsecond).String()
+	default:
+		return d.String()
+	}
+}
@@ -0,0 +1,111 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/rawbits2010/AoC25/internal/inputhandler"
+	"github.com/rawbits2010/AoC25/internal/inputhandler/aocclient"
+	"github.com/rawbits2010/AoC25/internal/solution"
+)
+
+// ErrNotAccepted is returned when the submitted answer was not the right one.
+var ErrNotAccepted = errors.New("the answer was not accepted")
+
+func submitCommand(args []string) error {
+
+	if len(args) < 2 {
+		return fmt.Errorf("%w: which day and part to submit?", inputhandler.ErrorInvalidParameters)
+	}
+
+	day, err := strconv.Atoi(args[0])
+	if err != nil {
+		return fmt.Errorf("%w: invalid day (%s)", inputhandler.ErrorInvalidParameters, args[0])
+	}
+	part, err := strconv.Atoi(args[1])
+	if err != nil || (part != 1 && part != 2) {
+		return fmt.Errorf("%w: part can only be 1 or 2 (%s)", inputhandler.ErrorInvalidParameters, args[1])
+	}
+	args = args[2:]
+
+	// the answer is optional, the solution is run if not given
+	answer := ""
+	if len(args) > 0 && !strings.HasPrefix(args[0], "-") {
+		answer = args[0]
+		args = args[1:]
+	}
+
+	config, err := inputhandler.ParseOptions(args)
+	if err != nil {
+		return err
+	}
+
+	// the answer is always for the real input and the part given above
+	if inputhandler.Example() {
+		return fmt.Errorf("%w: the example can't be submitted", inputhandler.ErrorInvalidParameters)
+	}
+	if inputhandler.Part() != 0 {
+		return fmt.Errorf("%w: the part is given without -part", inputhandler.ErrorInvalidParameters)
+	}
+
+	if answer == "" {
+		answer, err = solveForSubmit(day, part, config)
+		if err != nil {
+			return err
+		}
+	}
+
+	client, err := aocclient.New()
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Day %d part %d: %s\n", day, part, answer)
+
+	result, err := client.Submit(inputhandler.Year, day, part, answer)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Verdict: %s\n", result.Verdict)
+	fmt.Println(result.Message)
+	if result.Wait > 0 {
+		fmt.Printf("Wait %v before the next answer.\n", result.Wait)
+	}
+
+	if result.Verdict != aocclient.Correct {
+		return &inputhandler.Error{Code: inputhandler.ErrorCodeProcessing, Err: ErrNotAccepted}
+	}
+	return nil
+}
+
+// solveForSubmit runs the part of the day for the answer.
+func solveForSubmit(day, part int, config inputhandler.Config) (string, error) {
+
+	s, ok := solution.Get(day)
+	if !ok {
+		return "", fmt.Errorf("%w: there is no solution for day %d", inputhandler.ErrorInvalidParameters, day)
+	}
+
+	var err error
+	if config.Method == inputhandler.InputInvalid {
+		config, err = inputConfig(day, false)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	lines, err := inputhandler.Read(config)
+	if err != nil {
+		return "", err
+	}
+
+	result := solution.RunPart(s, lines, part)
+	if result.Err != nil {
+		return "", fmt.Errorf("day %d part %d: %w", day, part, result.Err)
+	}
+
+	return result.String(), nil
+}
@@ -0,0 +1,194 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/rawbits2010/AoC25/internal/answers"
+	"github.com/rawbits2010/AoC25/internal/inputhandler"
+	"github.com/rawbits2010/AoC25/internal/solution"
+)
+
+var answersFile = inputhandler.Options.String("answers", "answers.txt", "file of the known good answers for verify")
+var updateAnswers = inputhandler.Options.Bool("update", false, "verify writes the current answers into the answers file instead of failing (the examples of the tests are only checked)")
+
+// ErrMismatch is returned when verify found different answers.
+var ErrMismatch = errors.New("the answers differ from the known good ones")
+
+func verifyCommand(args []string) error {
+
+	days := solution.Days()
+	if len(args) > 0 && !strings.HasPrefix(args[0], "-") {
+		var err error
+		days, err = parseDays(args[0])
+		if err != nil {
+			return err
+		}
+		args = args[1:]
+	}
+
+	config, err := inputhandler.ParseOptions(args)
+	if err != nil {
+		return err
+	}
+	if config.Method != inputhandler.InputInvalid {
+		return fmt.Errorf("%w: verify reads the inputs listed in the answers file", inputhandler.ErrorInvalidParameters)
+	}
+
+	known, err := answers.Load(*answersFile)
+	if err != nil {
+		return &inputhandler.Error{Code: inputhandler.ErrorCodeFiles, Err: err}
+	}
+
+	examples, err := loadTestdataAnswers(days, known)
+	if err != nil {
+		return &inputhandler.Error{Code: inputhandler.ErrorCodeFiles, Err: err}
+	}
+
+	// the examples of the tests first, then the ones in the answers file
+	var diffs []diff
+	for i, answer := range append(examples, known...) {
+
+		if !slices.Contains(days, answer.Day) {
+			continue
+		}
+
+		// a day failing to run is a difference too, the rest are still checked
+		current, err := verifyAnswer(answer)
+
+		status := "OK"
+		switch {
+		case err != nil:
+			status = "FAIL"
+			diffs = append(diffs, diff{expected: answer, got: fmt.Sprintf("%d %s error: %v", answer.Day, answer.Input, err), err: err})
+		case current != answer:
+			status = "FAIL"
+			diffs = append(diffs, diff{expected: answer, got: current.String(), testdata: i < len(examples)})
+		}
+		fmt.Printf("%-4s  day %d %s\n", status, answer.Day, answer.Input)
+
+		if *updateAnswers && err == nil && i >= len(examples) {
+			known[i-len(examples)] = current
+		}
+	}
+
+	failed := slices.ContainsFunc(diffs, func(d diff) bool { return d.err != nil })
+
+	if *updateAnswers {
+		if err := answers.Save(*answersFile, known); err != nil {
+			return &inputhandler.Error{Code: inputhandler.ErrorCodeFiles, Err: err}
+		}
+		fmt.Printf("\nUpdated the answers in '%s'\n", *answersFile)
+
+		// only the failed ones and the examples of the tests are left to show
+		diffs = slices.DeleteFunc(diffs, func(d diff) bool { return d.err == nil && !d.testdata })
+	}
+
+	if len(diffs) == 0 {
+		return nil
+	}
+
+	source := *answersFile
+	if len(examples) > 0 {
+		source += " and " + filepath.Join("cmd", "dayNN", "testdata", testdataAnswersFile)
+	}
+	fmt.Printf("\n--- %s\n+++ current answers\n", source)
+	for _, d := range diffs {
+		fmt.Printf("-%s\n+%s\n", d.expected, d.got)
+	}
+
+	if failed {
+		return &inputhandler.Error{Code: inputhandler.ErrorCodeProcessing, Err: ErrFailed}
+	}
+	return &inputhandler.Error{Code: inputhandler.ErrorCodeProcessing, Err: ErrMismatch}
+}
+
+// diff is an answer verify found different, with the error if the day failed.
+type diff struct {
+	expected answers.Answer
+	got      string
+	err      error
+	// testdata is set for the examples of the tests, -update doesn't change those
+	testdata bool
+}
+
+// testdataAnswersFile has the answers of the examples in the testdata directory.
+const testdataAnswersFile = "answers.txt"
+
+// loadTestdataAnswers returns the answers of the examples of the tests
+// for the days, so they are only kept in one place. The days having an
+// example in the answers file, and the days without tests, are skipped.
+// With -dir there are none, the examples are read from there.
+func loadTestdataAnswers(days []int, known []answers.Answer) ([]answers.Answer, error) {
+
+	if *inputDir != "" {
+		return nil, nil
+	}
+
+	var examples []answers.Answer
+	for _, day := range days {
+
+		if slices.ContainsFunc(known, func(a answers.Answer) bool { return a.Day == day && a.Input == answers.Example }) {
+			continue
+		}
+
+		list, err := answers.LoadExamples(filepath.Join(inputhandler.TestdataDir(day), testdataAnswersFile))
+		if errors.Is(err, fs.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		for _, example := range list {
+			if example.Name == inputhandler.ExampleFile {
+				examples = append(examples, answers.Answer{Day: day, Input: answers.Example, Parts: example.Parts})
+			}
+		}
+	}
+
+	return examples, nil
+}
+
+// verifyAnswer runs the day on the input of the answer, and returns the
+// current answers. The parts with Unknown answers are left as they are,
+// as are the parts not run because of '--part'.
+func verifyAnswer(answer answers.Answer) (answers.Answer, error) {
+
+	if _, ok := solution.Get(answer.Day); !ok {
+		return answer, fmt.Errorf("%w: there is no solution for day %d in the answers file", inputhandler.ErrorInvalidParameters, answer.Day)
+	}
+
+	// the solutions can do things differently for the examples
+	example := answer.Input == answers.Example
+	inputhandler.Options.Set("example", strconv.FormatBool(example))
+
+	config, err := inputConfig(answer.Day, example)
+	if err != nil {
+		return answer, err
+	}
+
+	row := runDay(answer.Day, config)
+	if row.err != nil {
+		return answer, row.err
+	}
+
+	current := answer
+	for _, result := range row.results {
+		switch {
+		case result.Skipped():
+			current.Parts[result.Part-1] = answers.Unknown
+		case answer.Parts[result.Part-1] == answers.Unknown && !*updateAnswers:
+			// nothing to compare to
+		default:
+			current.Parts[result.Part-1] = result.String()
+		}
+	}
+
+	return current, nil
+}
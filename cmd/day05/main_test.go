@@ -0,0 +1,12 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/rawbits2010/AoC25/internal/days/day05"
+	"github.com/rawbits2010/AoC25/internal/solution/solutiontest"
+)
+
+func TestExamples(t *testing.T) {
+	solutiontest.RunExamples(t, day05.Day{})
+}
@@ -1,18 +1,10 @@
 package main
 
 import (
-	"fmt"
-
-	"github.com/rawbits2010/AoC25/internal/inputhandler"
+	"github.com/rawbits2010/AoC25/internal/days/template"
+	"github.com/rawbits2010/AoC25/internal/solution"
 )
 
 func main() {
-
-	lines := inputhandler.ReadInput()
-
-	var result int
-	// do something with input lines
-	_ = lines
-
-	fmt.Printf("Result - Part 1: %d, Part 2: %d\n", result, result)
+	solution.Main(template.Day{})
 }
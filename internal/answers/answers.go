@@ -0,0 +1,137 @@
+// answers package reads and writes the file of the known good answers,
+// so it can be checked that the solutions still give the same after a change.
+//
+// The file has one line for every day and input:
+//
+//	# day input part1 part2
+//	5 example 3 14
+//	5 input 640 365804144481581
+//
+// The input is 'example' for the puzzle example, or 'input' for the
+// puzzle input. Lines starting with '#' are comments, and Unknown
+// is for the answers not known yet.
+package answers
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Unknown is the answer not known yet - it's never compared.
+const Unknown = "-"
+
+// The inputs an answer can be for.
+const (
+	Example = "example"
+	Input   = "input"
+)
+
+// ErrSyntax is wrapped by the errors Parse and ParseExamples return on malformed lines.
+var ErrSyntax = errors.New("invalid answers line")
+
+// Answer is the known good answer of the two parts for a day and input.
+type Answer struct {
+	Day   int
+	Input string
+	Parts [2]string
+}
+
+// Load reads the answers file at the path.
+func Load(path string) ([]Answer, error) {
+
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	answers, err := Parse(file)
+	if err != nil {
+		return nil, fmt.Errorf("error reading answers file '%s': %w", path, err)
+	}
+
+	return answers, nil
+}
+
+// Parse reads the answers in the file format.
+func Parse(r io.Reader) ([]Answer, error) {
+
+	var answers []Answer
+	scanner := bufio.NewScanner(r)
+	for lineNum := 1; scanner.Scan(); lineNum++ {
+
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		fields := strings.Fields(line)
+		if len(fields) != 4 {
+			return nil, fmt.Errorf("%w at %d: expected 4 fields, got %d", ErrSyntax, lineNum, len(fields))
+		}
+
+		day, err := strconv.Atoi(fields[0])
+		if err != nil || day < 1 {
+			return nil, fmt.Errorf("%w at %d: invalid day (%s)", ErrSyntax, lineNum, fields[0])
+		}
+
+		if fields[1] != Example && fields[1] != Input {
+			return nil, fmt.Errorf("%w at %d: the input is either %s or %s (%s)", ErrSyntax, lineNum, Example, Input, fields[1])
+		}
+
+		for _, answer := range answers {
+			if answer.Day == day && answer.Input == fields[1] {
+				return nil, fmt.Errorf("%w at %d: day %d %s is already given", ErrSyntax, lineNum, day, fields[1])
+			}
+		}
+
+		answers = append(answers, Answer{
+			Day:   day,
+			Input: fields[1],
+			Parts: [2]string{fields[2], fields[3]},
+		})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return answers, nil
+}
+
+// Save writes the answers into the file at the path, ordered by day.
+// NOTE: the comments of the file are not kept, only the header is written.
+func Save(path string, answers []Answer) error {
+
+	var sb strings.Builder
+	Format(&sb, answers)
+
+	return os.WriteFile(path, []byte(sb.String()), 0644)
+}
+
+// Format writes the answers in the file format, ordered by day.
+func Format(w io.Writer, answers []Answer) {
+
+	sorted := append([]Answer{}, answers...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if sorted[i].Day != sorted[j].Day {
+			return sorted[i].Day < sorted[j].Day
+		}
+		return sorted[i].Input < sorted[j].Input
+	})
+
+	fmt.Fprintln(w, "# day input part1 part2")
+	for _, answer := range sorted {
+		fmt.Fprintln(w, answer)
+	}
+}
+
+// String returns the answer as a line of the file.
+func (a Answer) String() string {
+	return fmt.Sprintf("%d %s %s %s", a.Day, a.Input, a.Parts[0], a.Parts[1])
+}
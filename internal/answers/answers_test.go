@@ -0,0 +1,83 @@
+package answers
+
+import (
+	"errors"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+
+	text := `# comment
+5 input 640 -
+
+  1 example 3 6
+`
+	answers, err := Parse(strings.NewReader(text))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []Answer{
+		{5, Input, [2]string{"640", Unknown}},
+		{1, Example, [2]string{"3", "6"}},
+	}
+	if !slices.Equal(answers, expected) {
+		t.Errorf("expected %v, got %v", expected, answers)
+	}
+
+	for _, line := range []string{"1 example 3", "x example 3 6", "0 example 3 6", "1 other 3 6", "1 input 1 2\n1 input 3 4"} {
+		if _, err := Parse(strings.NewReader(line)); !errors.Is(err, ErrSyntax) {
+			t.Errorf("%q: expected ErrSyntax, got %v", line, err)
+		}
+	}
+}
+
+func TestSaveLoad(t *testing.T) {
+
+	path := filepath.Join(t.TempDir(), "answers.txt")
+	answers := []Answer{
+		{9, Example, [2]string{"50", "24"}},
+		{1, Input, [2]string{"1234", Unknown}},
+		{1, Example, [2]string{"3", "6"}},
+	}
+
+	if err := Save(path, answers); err != nil {
+		t.Fatal(err)
+	}
+	loaded, err := Load(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []Answer{answers[2], answers[1], answers[0]}
+	if !slices.Equal(loaded, expected) {
+		t.Errorf("expected %v, got %v", expected, loaded)
+	}
+}
+
+func TestParseExamples(t *testing.T) {
+
+	text := `# example part1 part2
+example1.txt 3 6
+example2.txt - 14
+`
+	examples, err := ParseExamples(strings.NewReader(text))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []ExampleAnswer{
+		{"example1.txt", [2]string{"3", "6"}},
+		{"example2.txt", [2]string{Unknown, "14"}},
+	}
+	if !slices.Equal(examples, expected) {
+		t.Errorf("expected %v, got %v", expected, examples)
+	}
+
+	if _, err := ParseExamples(strings.NewReader("example1.txt 3")); !errors.Is(err, ErrSyntax) {
+		t.Errorf("expected ErrSyntax, got %v", err)
+	}
+}
@@ -0,0 +1,69 @@
+package answers
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+// ExampleAnswer is the answer of the two parts for a puzzle example
+// in the testdata directory of a day. Their file has a line for every
+// example file:
+//
+//	# example part1 part2
+//	example1.txt 3 6
+//	example2.txt - 14
+//
+// Unknown is for the part the example is not for.
+type ExampleAnswer struct {
+	Name  string
+	Parts [2]string
+}
+
+// LoadExamples reads the example answers file at the path.
+func LoadExamples(path string) ([]ExampleAnswer, error) {
+
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	examples, err := ParseExamples(file)
+	if err != nil {
+		return nil, fmt.Errorf("error reading example answers file '%s': %w", path, err)
+	}
+
+	return examples, nil
+}
+
+// ParseExamples reads the example answers in the file format.
+func ParseExamples(r io.Reader) ([]ExampleAnswer, error) {
+
+	var examples []ExampleAnswer
+	scanner := bufio.NewScanner(r)
+	for lineNum := 1; scanner.Scan(); lineNum++ {
+
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		fields := strings.Fields(line)
+		if len(fields) != 3 {
+			return nil, fmt.Errorf("%w at %d: expected 3 fields, got %d", ErrSyntax, lineNum, len(fields))
+		}
+
+		examples = append(examples, ExampleAnswer{
+			Name:  fields[0],
+			Parts: [2]string{fields[1], fields[2]},
+		})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return examples, nil
+}
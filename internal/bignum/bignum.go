@@ -0,0 +1,307 @@
+// bignum package provides an arbitrary-precision decimal integer type
+// for the puzzles where the numbers won't fit into the native types.
+//
+// The digits are kept in base 10 so parsing and printing is cheap,
+// which is what most of the puzzles are doing anyway.
+//
+// NOTE: This package is not meant to compete with math/big. It is here
+// so the string arithmetic don't have to be copied into every solution.
+package bignum
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Int is a signed decimal integer of arbitrary size.
+// The zero value is ready to use and represents 0.
+// Operations never modify their operands, they return new values.
+type Int struct {
+	// digits in little-endian order (digits[0] is the ones place)
+	// without leading zeros - so 0 is an empty slice
+	digits []byte
+	// neg is never set for 0
+	neg bool
+}
+
+const zeroDigit = '0'
+
+// ErrSyntax is wrapped by the errors Parse returns on malformed input.
+var ErrSyntax = errors.New("invalid number syntax")
+
+// Parse converts a string of decimal digits with an optional leading
+// '+' or '-' sign into an Int. Leading zeros are accepted.
+// Returns an error wrapping ErrSyntax on empty or malformed input.
+func Parse(s string) (Int, error) {
+
+	numStr := s
+	neg := false
+	if len(numStr) > 0 && (numStr[0] == '-' || numStr[0] == '+') {
+		neg = numStr[0] == '-'
+		numStr = numStr[1:]
+	}
+
+	if len(numStr) == 0 {
+		return Int{}, fmt.Errorf("%w: no digits in number (%s)", ErrSyntax, s)
+	}
+
+	digits := make([]byte, len(numStr))
+	for i := 0; i < len(numStr); i++ {
+		if numStr[i] < '0' || numStr[i] > '9' {
+			return Int{}, fmt.Errorf("%w: invalid digit '%c' in number (%s)", ErrSyntax, numStr[i], s)
+		}
+		digits[len(numStr)-1-i] = numStr[i] - zeroDigit
+	}
+
+	return newInt(trim(digits), neg), nil
+}
+
+// MustParse is like Parse but panics on error. Meant for constants.
+func MustParse(s string) Int {
+	n, err := Parse(s)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+// NewInt creates an Int from a native signed integer.
+func NewInt(v int64) Int {
+	if v < 0 {
+		// negating in unsigned space so math.MinInt64 works too
+		return NewUint(uint64(-(v + 1)) + 1).Neg()
+	}
+	return NewUint(uint64(v))
+}
+
+// NewUint creates an Int from a native unsigned integer.
+func NewUint(v uint64) Int {
+	digits := make([]byte, 0, 20)
+	for v > 0 {
+		digits = append(digits, byte(v%10))
+		v /= 10
+	}
+	return Int{digits: digits}
+}
+
+// newInt makes sure 0 is never negative.
+func newInt(digits []byte, neg bool) Int {
+	return Int{digits: digits, neg: neg && len(digits) != 0}
+}
+
+// String returns the decimal representation of the number.
+func (a Int) String() string {
+
+	if len(a.digits) == 0 {
+		return "0"
+	}
+
+	var sb strings.Builder
+	sb.Grow(len(a.digits) + 1)
+	if a.neg {
+		sb.WriteByte('-')
+	}
+	for i := len(a.digits) - 1; i >= 0; i-- {
+		sb.WriteByte(a.digits[i] + zeroDigit)
+	}
+	return sb.String()
+}
+
+// IsZero reports whether the number is 0.
+func (a Int) IsZero() bool {
+	return len(a.digits) == 0
+}
+
+// Sign returns -1 if a < 0, 0 if a == 0 and +1 if a > 0.
+func (a Int) Sign() int {
+	switch {
+	case len(a.digits) == 0:
+		return 0
+	case a.neg:
+		return -1
+	default:
+		return 1
+	}
+}
+
+// Neg returns -a.
+func (a Int) Neg() Int {
+	return newInt(a.digits, !a.neg)
+}
+
+// Abs returns |a|.
+func (a Int) Abs() Int {
+	return Int{digits: a.digits}
+}
+
+// Cmp compares 'a' to 'b' and returns -1 if a < b, 0 if a == b and +1 if a > b.
+func (a Int) Cmp(b Int) int {
+
+	if a.neg != b.neg {
+		if a.neg {
+			return -1
+		}
+		return 1
+	}
+
+	if a.neg {
+		return cmpDigits(b.digits, a.digits)
+	}
+	return cmpDigits(a.digits, b.digits)
+}
+
+// Add returns a + b.
+func (a Int) Add(b Int) Int {
+
+	if a.neg == b.neg {
+		return newInt(addDigits(a.digits, b.digits), a.neg)
+	}
+
+	// different signs - substract the smaller magnitude from the bigger one
+	// and the result takes the sign of the bigger one
+	if cmpDigits(a.digits, b.digits) >= 0 {
+		return newInt(subDigits(a.digits, b.digits), a.neg)
+	}
+	return newInt(subDigits(b.digits, a.digits), b.neg)
+}
+
+// Sub returns a - b.
+func (a Int) Sub(b Int) Int {
+	return a.Add(b.Neg())
+}
+
+// Inc returns a + 1.
+func (a Int) Inc() Int {
+	return a.Add(one)
+}
+
+// Dec returns a - 1.
+func (a Int) Dec() Int {
+	return a.Sub(one)
+}
+
+// Mul returns a * b.
+func (a Int) Mul(b Int) Int {
+	return newInt(mulDigits(a.digits, b.digits), a.neg != b.neg)
+}
+
+// DivMod returns the quotient and the remainder of a / b.
+// It truncates towards zero like Go's / and % operators do,
+// so the remainder takes the sign of 'a'.
+// Panics if b is 0.
+func (a Int) DivMod(b Int) (Int, Int) {
+	if b.IsZero() {
+		panic("bignum: division by zero")
+	}
+	q, r := divModDigits(a.digits, b.digits)
+	return newInt(q, a.neg != b.neg), newInt(r, a.neg)
+}
+
+var one = Int{digits: []byte{1}}
+
+//-Digit-level-helpers---------------------------------------------------------
+
+// trim removes the leading (most significant) zeros.
+func trim(digits []byte) []byte {
+	i := len(digits)
+	for i > 0 && digits[i-1] == 0 {
+		i--
+	}
+	return digits[:i]
+}
+
+func cmpDigits(a, b []byte) int {
+
+	if len(a) != len(b) {
+		if len(a) < len(b) {
+			return -1
+		}
+		return 1
+	}
+
+	for i := len(a) - 1; i >= 0; i-- {
+		if a[i] < b[i] {
+			return -1
+		}
+		if a[i] > b[i] {
+			return 1
+		}
+	}
+
+	return 0
+}
+
+func addDigits(a, b []byte) []byte {
+
+	if len(a) < len(b) {
+		a, b = b, a
+	}
+
+	sum := make([]byte, len(a)+1)
+	carry := byte(0)
+	for i := 0; i < len(a); i++ {
+		s := a[i] + carry
+		if i < len(b) {
+			s += b[i]
+		}
+		if s > 9 {
+			s -= 10
+			carry = 1
+		} else {
+			carry = 0
+		}
+		sum[i] = s
+	}
+	sum[len(a)] = carry
+
+	return trim(sum)
+}
+
+// subDigits returns a - b. The caller has to make sure a >= b.
+func subDigits(a, b []byte) []byte {
+
+	diff := make([]byte, len(a))
+	borrow := 0
+	for i := 0; i < len(a); i++ {
+		d := int(a[i]) - borrow
+		if i < len(b) {
+			d -= int(b[i])
+		}
+		if d < 0 {
+			d += 10
+			borrow = 1
+		} else {
+			borrow = 0
+		}
+		diff[i] = byte(d)
+	}
+
+	return trim(diff)
+}
+
+// divModDigits is the long division taking one digit of the dividend at a time.
+func divModDigits(a, b []byte) ([]byte, []byte) {
+
+	if cmpDigits(a, b) < 0 {
+		return nil, a
+	}
+
+	quot := make([]byte, len(a))
+	var rem []byte
+	for i := len(a) - 1; i >= 0; i-- {
+
+		// rem = rem*10 + a[i]
+		rem = trim(append([]byte{a[i]}, rem...))
+
+		// at most 9 substractions per digit
+		q := byte(0)
+		for cmpDigits(rem, b) >= 0 {
+			rem = subDigits(rem, b)
+			q++
+		}
+		quot[i] = q
+	}
+
+	return trim(quot), rem
+}
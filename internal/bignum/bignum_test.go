@@ -0,0 +1,156 @@
+package bignum
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"math/rand"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func randomDigits(rnd *rand.Rand, count int) string {
+	var sb strings.Builder
+	sb.WriteByte(byte('1' + rnd.Intn(9)))
+	for i := 1; i < count; i++ {
+		sb.WriteByte(byte('0' + rnd.Intn(10)))
+	}
+	return sb.String()
+}
+
+func TestMul(t *testing.T) {
+
+	rnd := rand.New(rand.NewSource(25))
+
+	sizes := [][2]int{{1, 1}, {3, 17}, {47, 48}, {48, 48}, {100, 3}, {100, 99}, {257, 1000}, {1500, 1500}}
+	for _, size := range sizes {
+		aStr := randomDigits(rnd, size[0])
+		bStr := randomDigits(rnd, size[1])
+
+		aBig, _ := new(big.Int).SetString(aStr, 10)
+		bBig, _ := new(big.Int).SetString(bStr, 10)
+		expected := new(big.Int).Mul(aBig, bBig).String()
+
+		result := MustParse(aStr).Mul(MustParse(bStr)).String()
+		if result != expected {
+			t.Errorf("%dx%d digits: got %s, expected %s", size[0], size[1], result, expected)
+		}
+	}
+
+	if result := MustParse("123456789").Mul(Int{}).String(); result != "0" {
+		t.Errorf("multiplying by 0: got %s", result)
+	}
+	if result := MustParse("-12").Mul(MustParse("3")).String(); result != "-36" {
+		t.Errorf("multiplying negative: got %s", result)
+	}
+	if result := MustParse("-12").Mul(MustParse("-3")).String(); result != "36" {
+		t.Errorf("multiplying negatives: got %s", result)
+	}
+}
+
+func TestArithmetic(t *testing.T) {
+
+	cases := []struct {
+		a, b                string
+		sum, diff           string
+		quotient, remainder string
+		cmp                 int
+	}{
+		{"0", "1", "1", "-1", "0", "0", -1},
+		{"10", "10", "20", "0", "1", "0", 0},
+		{"1000", "1", "1001", "999", "1000", "0", 1},
+		{"99999999999999999999", "7", "100000000000000000006", "99999999999999999992", "14285714285714285714", "1", 1},
+		{"000123", "45", "168", "78", "2", "33", 1},
+		{"3", "5", "8", "-2", "0", "3", -1},
+		{"-5", "3", "-2", "-8", "-1", "-2", -1},
+		{"5", "-3", "2", "8", "-1", "2", 1},
+		{"-5", "-3", "-8", "-2", "1", "-2", -1},
+		{"-3", "-5", "-8", "2", "0", "-3", 1},
+		{"-7", "7", "0", "-14", "-1", "0", -1},
+		{"+12", "-0", "12", "12", "", "", 1},
+	}
+
+	for _, c := range cases {
+		a := MustParse(c.a)
+		b := MustParse(c.b)
+
+		if got := a.Add(b).String(); got != c.sum {
+			t.Errorf("%s + %s: got %s, expected %s", c.a, c.b, got, c.sum)
+		}
+		if got := a.Sub(b).String(); got != c.diff {
+			t.Errorf("%s - %s: got %s, expected %s", c.a, c.b, got, c.diff)
+		}
+		if !b.IsZero() {
+			q, r := a.DivMod(b)
+			if q.String() != c.quotient || r.String() != c.remainder {
+				t.Errorf("%s / %s: got %s r %s, expected %s r %s", c.a, c.b, q, r, c.quotient, c.remainder)
+			}
+		}
+		if got := a.Cmp(b); got != c.cmp {
+			t.Errorf("%s cmp %s: got %d, expected %d", c.a, c.b, got, c.cmp)
+		}
+	}
+}
+
+func TestParseInvalid(t *testing.T) {
+	for _, s := range []string{"", "12a", " 1", "1.5", "-", "+", "--1", "1-"} {
+		if _, err := Parse(s); !errors.Is(err, ErrSyntax) {
+			t.Errorf("expected syntax error parsing (%s), got %v", s, err)
+		}
+	}
+}
+
+func TestNewInt(t *testing.T) {
+	for _, v := range []int64{0, 1, -1, 1234567890, math.MaxInt64, math.MinInt64} {
+		if got := NewInt(v).String(); got != strconv.FormatInt(v, 10) {
+			t.Errorf("NewInt(%d): got %s", v, got)
+		}
+	}
+	if got := MustParse("-0").String(); got != "0" {
+		t.Errorf("parsing -0: got %s", got)
+	}
+}
+
+var benchSizes = []int{10, 100, 1000, 10000}
+
+func BenchmarkMulSchoolbook(b *testing.B) {
+	for _, size := range benchSizes {
+		x, y := benchOperands(size)
+		b.Run(fmt.Sprintf("digits=%d", size), func(b *testing.B) {
+			for b.Loop() {
+				mulSchoolbook(x.digits, y.digits)
+			}
+		})
+	}
+}
+
+func BenchmarkMul(b *testing.B) {
+	for _, size := range benchSizes {
+		x, y := benchOperands(size)
+		b.Run(fmt.Sprintf("digits=%d", size), func(b *testing.B) {
+			for b.Loop() {
+				x.Mul(y)
+			}
+		})
+	}
+}
+
+func BenchmarkMulMathBig(b *testing.B) {
+	for _, size := range benchSizes {
+		x, y := benchOperands(size)
+		xBig, _ := new(big.Int).SetString(x.String(), 10)
+		yBig, _ := new(big.Int).SetString(y.String(), 10)
+		b.Run(fmt.Sprintf("digits=%d", size), func(b *testing.B) {
+			for b.Loop() {
+				new(big.Int).Mul(xBig, yBig)
+			}
+		})
+	}
+}
+
+func benchOperands(size int) (Int, Int) {
+	rnd := rand.New(rand.NewSource(int64(size)))
+	return MustParse(randomDigits(rnd, size)), MustParse(randomDigits(rnd, size))
+}
@@ -0,0 +1,85 @@
+package bignum
+
+// karatsubaThreshold is the digit count below which the schoolbook
+// multiplication is faster than splitting the numbers any further.
+// Picked by running the benchmarks - see BenchmarkMul*.
+var karatsubaThreshold = 48
+
+// mulDigits multiplies two digit slices choosing the algorithm by size.
+func mulDigits(a, b []byte) []byte {
+
+	if len(a) == 0 || len(b) == 0 {
+		return nil
+	}
+
+	if len(a) < karatsubaThreshold || len(b) < karatsubaThreshold {
+		return mulSchoolbook(a, b)
+	}
+
+	return mulKaratsuba(a, b)
+}
+
+// mulSchoolbook is the long multiplication done on paper. O(n*m)
+func mulSchoolbook(a, b []byte) []byte {
+
+	// accumulate without carrying first, 9*9*len fits easily
+	acc := make([]int, len(a)+len(b))
+	for i := 0; i < len(a); i++ {
+		if a[i] == 0 {
+			continue
+		}
+		for j := 0; j < len(b); j++ {
+			acc[i+j] += int(a[i]) * int(b[j])
+		}
+	}
+
+	prod := make([]byte, len(acc))
+	carry := 0
+	for i := 0; i < len(acc); i++ {
+		v := acc[i] + carry
+		prod[i] = byte(v % 10)
+		carry = v / 10
+	}
+
+	return trim(prod)
+}
+
+// mulKaratsuba splits both numbers in half at 'm' digits, so
+//
+//	a*b = z2*10^(2m) + z1*10^m + z0
+//
+// where z2 = a1*b1, z0 = a0*b0 and z1 = (a0+a1)*(b0+b1) - z2 - z0,
+// which is 3 multiplications of half the size instead of 4. O(n^1.585)
+func mulKaratsuba(a, b []byte) []byte {
+
+	m := max(len(a), len(b)) / 2
+
+	a0, a1 := split(a, m)
+	b0, b1 := split(b, m)
+
+	z0 := mulDigits(a0, b0)
+	z2 := mulDigits(a1, b1)
+	z1 := mulDigits(addDigits(a0, a1), addDigits(b0, b1))
+	z1 = subDigits(z1, addDigits(z0, z2))
+
+	prod := addDigits(shift(z2, 2*m), shift(z1, m))
+	return addDigits(prod, z0)
+}
+
+// split cuts the digits into the low 'm' and the remaining high part.
+func split(digits []byte, m int) ([]byte, []byte) {
+	if len(digits) <= m {
+		return digits, nil
+	}
+	return trim(digits[:m]), digits[m:]
+}
+
+// shift multiplies by 10^n by prepending zeros to the low end.
+func shift(digits []byte, n int) []byte {
+	if len(digits) == 0 {
+		return nil
+	}
+	shifted := make([]byte, n+len(digits))
+	copy(shifted[n:], digits)
+	return shifted
+}
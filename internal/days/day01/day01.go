@@ -0,0 +1,109 @@
+package day01
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/rawbits2010/AoC25/internal/inputhandler"
+	"github.com/rawbits2010/AoC25/internal/solution"
+)
+
+var startPos = inputhandler.Options.Uint("start", 50, "starting position of the dial")
+
+func init() {
+	solution.Register(1, Day{})
+}
+
+// Day is the solution for day 1.
+type Day struct{}
+
+func (Day) Part1(lines []string) (any, error) {
+	countZeros, _, err := turnDial(lines)
+	return countZeros, err
+}
+
+func (Day) Part2(lines []string) (any, error) {
+	countZeros, countPasses, err := turnDial(lines)
+	return countPasses + countZeros, err
+}
+
+// turnDial follows the rotations and counts how many times the dial
+// stopped at 0 and how many times it passed 0 in between.
+func turnDial(lines []string) (uint, uint, error) {
+
+	if *startPos > 99 {
+		return 0, 0, fmt.Errorf("the dial only goes from 0 to 99 (%d)", *startPos)
+	}
+
+	countPasses := uint(0)
+	countZeros := uint(0)
+	currPos := *startPos
+	for _, line := range lines {
+
+		dir, amount, err := parseLine(line)
+		if err != nil {
+			return 0, 0, fmt.Errorf("error parsing line (%s): %w", line, err)
+		}
+
+		countPasses += amount / 100
+
+		amount %= 100
+
+		prevPos := currPos
+		switch dir {
+		case 'R':
+			currPos = (currPos + amount) % 100
+
+			if prevPos > currPos && prevPos != 0 && currPos != 0 {
+				countPasses++
+			}
+
+		case 'L':
+			if amount > currPos {
+				currPos = 100 - (amount - currPos)
+			} else {
+				currPos -= amount
+			}
+
+			if prevPos < currPos && prevPos != 0 && currPos != 0 {
+				countPasses++
+			}
+		}
+
+		if currPos == 0 {
+			countZeros++
+		}
+
+		if inputhandler.Verbose() {
+			fmt.Printf("dir: %s, amount: %d, prevPos: %d, curPos: %d, pass: %d\n", string(dir), amount, prevPos, currPos, countPasses)
+		}
+	}
+
+	return countZeros, countPasses, nil
+}
+
+type Direction byte
+
+const (
+	Left  Direction = 'L'
+	Right Direction = 'R'
+)
+
+func parseLine(line string) (Direction, uint, error) {
+
+	if len(line) < 2 {
+		return 0, 0, fmt.Errorf("malformed input")
+	}
+
+	dir := line[0]
+	if dir != 'L' && dir != 'R' {
+		return 0, 0, fmt.Errorf("invalid direction: %s", string(dir))
+	}
+
+	amount, err := strconv.ParseUint(line[1:], 10, 64)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid number (%s): %w", line[1:], err)
+	}
+
+	return Direction(dir), uint(amount), nil
+}
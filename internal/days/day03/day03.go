@@ -0,0 +1,75 @@
+package day03
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/rawbits2010/AoC25/internal/solution"
+)
+
+func init() {
+	solution.Register(3, Day{})
+}
+
+// Day is the solution for day 3.
+type Day struct{}
+
+func (Day) Part1(lines []string) (any, error) {
+	return sumJoltages(lines, 2)
+}
+
+func (Day) Part2(lines []string) (any, error) {
+	return sumJoltages(lines, 12)
+}
+
+func sumJoltages(lines []string, digitCount int) (int, error) {
+
+	sum := 0
+	for _, line := range lines {
+
+		if len(line) < digitCount {
+			return 0, fmt.Errorf("not enough batteries in bank (%s)", line)
+		}
+
+		numStr := joltageSearch(line, digitCount)
+
+		num, err := strconv.Atoi(numStr)
+		if err != nil {
+			return 0, fmt.Errorf("error converting jolts (%s): %w", numStr, err)
+		}
+
+		sum += num
+	}
+
+	return sum, nil
+}
+
+func joltageSearch(line string, digitCount int) string {
+
+	nums := make([]byte, digitCount)
+	idx := -1
+	for i := 1; i <= digitCount; i++ {
+
+		num, newIdx := findLargestNumber(line[idx+1 : len(line)-(digitCount-i)])
+		idx = newIdx + idx + 1
+
+		nums[i-1] = num
+	}
+
+	return string(nums)
+}
+
+func findLargestNumber(bank string) (byte, int) {
+	max := byte(0)
+	idx := 0
+	for i := 0; i < len(bank); i++ {
+		if bank[i] > max {
+			max = bank[i]
+			idx = i
+		}
+	}
+
+	//fmt.Println(bank, "-", max, "/", idx)
+
+	return max, idx
+}
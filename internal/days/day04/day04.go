@@ -0,0 +1,99 @@
+package day04
+
+import (
+	"github.com/rawbits2010/AoC25/internal/solution"
+)
+
+func init() {
+	solution.Register(4, Day{})
+}
+
+// Day is the solution for day 4.
+type Day struct{}
+
+func (Day) Part1(lines []string) (any, error) {
+	_, count := removeAccessible(lines)
+	return count, nil
+}
+
+func (Day) Part2(lines []string) (any, error) {
+	return removeAllAccessible(lines), nil
+}
+
+const Roll = '@'
+const Empty = '.'
+
+// removeAllAccessible keeps removing the accessible rolls
+// until there are none left and returns how many were removed.
+func removeAllAccessible(lines []string) int {
+
+	var count int
+	for {
+		var removedCount int
+		lines, removedCount = removeAccessible(lines)
+
+		count += removedCount
+
+		if removedCount == 0 {
+			break
+		}
+	}
+
+	return count
+}
+
+func removeAccessible(lines []string) ([]string, int) {
+
+	tempLines := make([]string, len(lines))
+
+	rollCount := 0
+	for i := 0; i < len(lines); i++ {
+
+		tempBytes := make([]byte, len(lines[i]))
+		for j := 0; j < len(lines[i]); j++ {
+
+			if lines[i][j] != Roll {
+				tempBytes[j] = lines[i][j]
+				continue
+			}
+
+			count := 0
+			if i > 0 && j > 0 && lines[i-1][j-1] == Roll {
+				count++
+			}
+			if i > 0 && lines[i-1][j] == Roll {
+				count++
+			}
+			if i > 0 && j < len(lines[i])-1 && lines[i-1][j+1] == Roll {
+				count++
+			}
+			if j > 0 && lines[i][j-1] == Roll {
+				count++
+			}
+			if j < len(lines[i])-1 && lines[i][j+1] == Roll {
+				count++
+			}
+			if i < len(lines)-1 && j > 0 && lines[i+1][j-1] == Roll {
+				count++
+			}
+			if i < len(lines)-1 && lines[i+1][j] == Roll {
+				count++
+			}
+			if i < len(lines)-1 && j < len(lines[i])-1 && lines[i+1][j+1] == Roll {
+				count++
+			}
+
+			if count < 4 {
+				tempBytes[j] = Empty
+				rollCount++
+			} else {
+				tempBytes[j] = Roll
+			}
+
+		}
+
+		tempLines[i] = string(tempBytes)
+	}
+
+	return tempLines, rollCount
+}
@@ -0,0 +1,126 @@
+package day05
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/rawbits2010/AoC25/internal/bignum"
+	"github.com/rawbits2010/AoC25/internal/inputhandler"
+	"github.com/rawbits2010/AoC25/internal/intervalset"
+	"github.com/rawbits2010/AoC25/internal/solution"
+)
+
+func init() {
+	solution.Register(5, Day{})
+}
+
+// Day is the solution for day 5.
+type Day struct{}
+
+func (Day) Part1(lines []string) (any, error) {
+
+	freshIds, lastRangeIdx, err := readRanges(lines)
+	if err != nil {
+		return nil, fmt.Errorf("error processing ranges: %w", err)
+	}
+
+	freshCount, err := countFreshIngredients(freshIds, lines[lastRangeIdx+1:])
+	if err != nil {
+		return nil, fmt.Errorf("error processing ingredients: %w", err)
+	}
+
+	return freshCount, nil
+}
+
+func (Day) Part2(lines []string) (any, error) {
+
+	freshIds, _, err := readRanges(lines)
+	if err != nil {
+		return nil, fmt.Errorf("error processing ranges: %w", err)
+	}
+
+	return sumFreshIds(freshIds), nil
+}
+
+func readRanges(lines []string) (*intervalset.Set, int, error) {
+
+	if len(lines) == 0 {
+		return nil, -1, fmt.Errorf("empty database provided")
+	}
+
+	freshIds := &intervalset.Set{}
+
+	for currIdx, line := range lines {
+
+		if len(line) == 0 {
+			return freshIds, currIdx - 1, nil
+		}
+
+		limits := strings.Split(line, "-")
+		if len(limits) == 1 {
+			if currIdx != 0 {
+				return nil, 0, fmt.Errorf("malformed data found at %d (%s)", currIdx, line)
+			} else {
+				return freshIds, 0, nil
+			}
+		} else if len(limits) > 2 {
+			return nil, 0, fmt.Errorf("malformed data found at %d (%s)", currIdx, line)
+		}
+
+		start, err := bignum.Parse(limits[0])
+		if err != nil {
+			return nil, 0, fmt.Errorf("invalid range start at %d (%s): %w", currIdx, line, err)
+		}
+		end, err := bignum.Parse(limits[1])
+		if err != nil {
+			return nil, 0, fmt.Errorf("invalid range end at %d (%s): %w", currIdx, line, err)
+		}
+		if end.Cmp(start) < 0 {
+			return nil, 0, fmt.Errorf("range end is lower than start at %d (%s)", currIdx, line)
+		}
+
+		freshIds.Insert(start, end)
+	}
+
+	return freshIds, len(lines) - 1, nil
+}
+
+func countFreshIngredients(freshIds *intervalset.Set, ingredientIds []string) (int, error) {
+
+	freshCount := 0
+	for idIdx, idStr := range ingredientIds {
+
+		if len(idStr) == 0 {
+			continue
+		}
+
+		id, err := bignum.Parse(idStr)
+		if err != nil {
+			return 0, fmt.Errorf("invalid ingredient id at %d (%s): %w", idIdx, idStr, err)
+		}
+
+		if freshIds.Contains(id) {
+			freshCount++
+		}
+	}
+
+	return freshCount, nil
+}
+
+func sumFreshIds(freshIds *intervalset.Set) bignum.Int {
+
+	// ranges are already merged, no overlaps to worry about
+	var idCount bignum.Int
+	for _, idRange := range freshIds.Intervals() {
+
+		count := idRange.Count()
+
+		idCount = idCount.Add(count)
+
+		if inputhandler.Verbose() {
+			fmt.Printf("rEnd: %s, rStart: %s, count: %s, idCount: %s\n", idRange.End, idRange.Start, count, idCount)
+		}
+	}
+
+	return idCount
+}
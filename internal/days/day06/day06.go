@@ -0,0 +1,288 @@
+package day06
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/rawbits2010/AoC25/internal/bignum"
+	"github.com/rawbits2010/AoC25/internal/solution"
+)
+
+func init() {
+	solution.Register(6, Day{})
+}
+
+// Day is the solution for day 6.
+type Day struct{}
+
+func (Day) Part1(lines []string) (any, error) {
+
+	if len(lines) == 0 {
+		return nil, fmt.Errorf("no input provided")
+	}
+
+	aochs, err := createAOChs(lines[len(lines)-1])
+	if err != nil {
+		return nil, fmt.Errorf("error parsing operations line: %w", err)
+	}
+
+	aochs, err = doTheMath(lines[:len(lines)-1], aochs)
+	if err != nil {
+		return nil, fmt.Errorf("error processing operations: %w", err)
+	}
+
+	return sumResults(aochs), nil
+}
+
+func (Day) Part2(lines []string) (any, error) {
+
+	if len(lines) == 0 {
+		return nil, fmt.Errorf("no input provided")
+	}
+
+	aochs, err := createAOChsP2(lines[len(lines)-1])
+	if err != nil {
+		return nil, fmt.Errorf("error parsing operations line: %w", err)
+	}
+
+	aochs, err = doTheMathP2(lines[:len(lines)-1], aochs)
+	if err != nil {
+		return nil, fmt.Errorf("error processing operations: %w", err)
+	}
+
+	return sumResults(aochs), nil
+}
+
+func sumResults(aochs []AOCh) bignum.Int {
+	var sum bignum.Int
+	for _, chain := range aochs {
+		sum = sum.Add(chain.Accumulator)
+	}
+	return sum
+}
+
+//
+//-Part 2----
+
+func doTheMathP2(valueLines []string, aochs []AOCh) ([]AOCh, error) {
+
+	expOperandSize := len(valueLines)
+
+	if expOperandSize == 0 {
+		return nil, fmt.Errorf("no value lines provided")
+	}
+
+	for i := 0; i < len(aochs); i++ {
+		for j := 0; j < len(aochs[i].Operands); j++ {
+			aochs[i].Operands[j] = make([]byte, expOperandSize)
+		}
+	}
+
+	// read the operands
+	for lineIdx, line := range valueLines {
+		for i := 0; i < len(aochs); i++ {
+
+			expNumDigits := len(aochs[i].Operands)
+
+			if len(line) < expNumDigits {
+				return nil, fmt.Errorf("malformed value line at %d - mismatched number of values (at val idx %d)", lineIdx, i)
+			}
+
+			val := line[:expNumDigits]
+			line = line[expNumDigits:]
+
+			for dIdx := 0; dIdx < expNumDigits; dIdx++ {
+				aochs[i].Operands[dIdx][lineIdx] = val[dIdx]
+			}
+
+			if len(line) > 0 {
+				line = line[1:] // +1 whitespace separator
+			}
+		}
+		if len(line) > 0 {
+			log.Printf("WARNING: malformed value line - extra characters in line at %d (%s)\n", lineIdx, line)
+		}
+	}
+
+	cleanStr := func(val []byte) string {
+		numStr := string(val)
+		numStr = strings.TrimSpace(numStr)
+		return numStr
+	}
+
+	// do math
+	for i := 0; i < len(aochs); i++ {
+
+		for opsIdx, operand := range aochs[i].Operands {
+
+			val, err := bignum.Parse(cleanStr(operand))
+			if err != nil {
+				return nil, fmt.Errorf("malformed operand in column %d: %w", i, err)
+			}
+
+			if opsIdx == 0 {
+				aochs[i].Accumulator = val
+				continue
+			}
+
+			switch aochs[i].Op {
+			case Addition:
+				aochs[i].Accumulator = aochs[i].Accumulator.Add(val)
+			case Multiplication:
+				aochs[i].Accumulator = aochs[i].Accumulator.Mul(val)
+			default:
+				return nil, fmt.Errorf("unsupported operation found (%s)", aochs[i].Op)
+			}
+		}
+	}
+
+	return aochs, nil
+}
+
+func createAOChsP2(opsLine string) ([]AOCh, error) {
+
+	if len(opsLine) == 0 {
+		return nil, fmt.Errorf("no operatoions found")
+	}
+
+	ops := make([]Operation, 0, 100)
+	digitCount := make([]int, 0, 100)
+
+	currCount := 0
+	for _, char := range opsLine {
+		switch string(char) {
+		case string(Addition):
+			ops = append(ops, Addition)
+			digitCount = append(digitCount, currCount-1)
+		case string(Multiplication):
+			ops = append(ops, Multiplication)
+			digitCount = append(digitCount, currCount-1)
+		case " ", "\t":
+			currCount++
+			continue
+		default:
+			return nil, fmt.Errorf("invalid operation found (%s)", string(char))
+		}
+		currCount = 1
+	}
+	digitCount = append(digitCount, currCount)
+	digitCount = digitCount[1:]
+
+	aoch := make([]AOCh, len(ops))
+	for opIdx, op := range ops {
+		aoch[opIdx].Op = op
+		aoch[opIdx].Operands = make([][]byte, digitCount[opIdx])
+	}
+
+	return aoch, nil
+}
+
+//
+//-Part 1----
+
+func doTheMath(valueLines []string, aochs []AOCh) ([]AOCh, error) {
+
+	if len(valueLines) == 0 {
+		return nil, fmt.Errorf("no value lines provided")
+	}
+
+	// filling in starting values
+	values, err := parseValueLine(valueLines[0])
+	if err != nil {
+		return nil, fmt.Errorf("error parsing line (%d): %w", 0, err)
+	}
+
+	if len(values) != len(aochs) {
+		return nil, fmt.Errorf("mismatched number of values (%d to %d ops)", len(values), len(aochs))
+	}
+
+	for valIdx, val := range values {
+		aochs[valIdx].Accumulator = val
+	}
+
+	// do operations line by line
+	for i := 1; i < len(valueLines); i++ {
+
+		values, err = parseValueLine(valueLines[i])
+		if err != nil {
+			return nil, fmt.Errorf("error parsing line (%d): %w", i, err)
+		}
+
+		if len(values) != len(aochs) {
+			return nil, fmt.Errorf("mismatched number of values (%d to %d ops)", len(values), len(aochs))
+		}
+
+		for valIdx, val := range values {
+			switch aochs[valIdx].Op {
+			case Addition:
+				aochs[valIdx].Accumulator = aochs[valIdx].Accumulator.Add(val)
+			case Multiplication:
+				aochs[valIdx].Accumulator = aochs[valIdx].Accumulator.Mul(val)
+			default:
+				return nil, fmt.Errorf("unsupported operation found (%s)", aochs[valIdx].Op)
+			}
+		}
+
+	}
+
+	return aochs, nil
+}
+
+func parseValueLine(valueLine string) ([]bignum.Int, error) {
+
+	if len(valueLine) == 0 {
+		return nil, fmt.Errorf("no values found")
+	}
+
+	fields := strings.Fields(valueLine)
+
+	values := make([]bignum.Int, len(fields))
+	for fieldIdx, field := range fields {
+		val, err := bignum.Parse(field)
+		if err != nil {
+			return nil, fmt.Errorf("malformed value at %d: %w", fieldIdx, err)
+		}
+		values[fieldIdx] = val
+	}
+
+	return values, nil
+}
+
+func createAOChs(opsLine string) ([]AOCh, error) {
+
+	if len(opsLine) == 0 {
+		return nil, fmt.Errorf("no operatoions found")
+	}
+
+	ops := strings.Fields(opsLine)
+
+	aoch := make([]AOCh, len(ops))
+	for opIdx, op := range ops {
+
+		switch op {
+		case string(Addition):
+			aoch[opIdx].Op = Addition
+		case string(Multiplication):
+			aoch[opIdx].Op = Multiplication
+		default:
+			return nil, fmt.Errorf("invalid operation found (%s)", op)
+		}
+	}
+
+	return aoch, nil
+}
+
+type Operation string
+
+const (
+	Addition       Operation = "+"
+	Multiplication Operation = "*"
+)
+
+// Aritmetic Operation Chain
+type AOCh struct {
+	Op          Operation
+	Accumulator bignum.Int
+	Operands    [][]byte
+}
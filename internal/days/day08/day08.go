@@ -0,0 +1,294 @@
+package day08
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/rawbits2010/AoC25/internal/inputhandler"
+	"github.com/rawbits2010/AoC25/internal/solution"
+)
+
+var connLimit = inputhandler.Options.Int("limit", 0, "number of closest box pairs to connect in part 1 (default 1000, or 10 for the example)")
+var topCount = inputhandler.Options.Int("top", 3, "number of largest circuits to multiply the sizes of in part 1")
+
+func init() {
+	solution.Register(8, Day{})
+}
+
+// Day is the solution for day 8.
+type Day struct{}
+
+func (Day) Part1(lines []string) (any, error) {
+
+	coords, err := readCoords(lines)
+	if err != nil {
+		return nil, fmt.Errorf("error reading coords: %w", err)
+	}
+
+	limit := *connLimit
+	if limit == 0 {
+		limit = 1000
+		if inputhandler.Example() {
+			limit = 10
+		}
+	}
+
+	circuits := letsMakeContact(NewPairStream(coords), len(coords), limit)
+	/*
+		for _, c := range circuits {
+			c.Print()
+		}
+	*/
+	sort.Slice(circuits, func(i, j int) bool {
+		return circuits[i].Count() > circuits[j].Count()
+	})
+
+	if *topCount < 1 || *topCount > len(circuits) {
+		return nil, fmt.Errorf("can't multiply the top %d circuits, there are only %d", *topCount, len(circuits))
+	}
+
+	result := 1
+	for _, c := range circuits[:*topCount] {
+		result *= c.Count()
+	}
+
+	return result, nil
+}
+
+func (Day) Part2(lines []string) (any, error) {
+
+	coords, err := readCoords(lines)
+	if err != nil {
+		return nil, fmt.Errorf("error reading coords: %w", err)
+	}
+
+	if len(coords) < 2 {
+		return nil, fmt.Errorf("can't connect the boxes, there are only %d", len(coords))
+	}
+
+	lastConnDist := letsMakeContactP2(coords)
+
+	return coords[lastConnDist.b1Idx].x * coords[lastConnDist.b2Idx].x, nil
+}
+
+// letsMakeContactP2 connects the boxes into one circuit the way connecting
+// the closest ones one by one would, and returns the last connection made.
+// That's the longest edge of the minimum spanning tree.
+//
+// Going through the pairs in order (Kruskal) needs every pair shorter than
+// that edge, which is most of them when the boxes are in far apart groups.
+// So this is Borůvka's algorithm instead: in every round each circuit gets
+// connected to the closest box outside of it, found with the k-d tree. The
+// number of circuits at least halves every round, so there are only log n
+// of them. The pairs are ordered the same way as in PairStream, so ties
+// give the same tree too.
+func letsMakeContactP2(coords []Coords) Distance {
+
+	tree := NewKDTree(coords)
+	circuits := NewCircuits(len(coords))
+
+	circuitOf := make([]int, len(coords))
+	closest := make([]Distance, len(coords))
+
+	var lastConn Distance
+	for circuits.Count() > 1 {
+
+		for box := range coords {
+			circuitOf[box] = circuits.Find(box)
+			closest[box] = Distance{-1, -1, math.MaxInt}
+		}
+		labels := tree.CircuitLabels(circuitOf)
+
+		for box := range coords {
+			own := circuitOf[box]
+			closest[own] = tree.NearestOutside(box, circuitOf, labels, closest[own])
+		}
+
+		for box, dist := range closest {
+			if circuitOf[box] != box {
+				continue
+			}
+			if circuits.Connect(dist.b1Idx, dist.b2Idx) && lastConn.Less(dist) {
+				lastConn = dist
+			}
+		}
+	}
+
+	return lastConn
+}
+
+func letsMakeContact(pairs *PairStream, numBoxes int, limit int) []*Circuit {
+
+	circuits := NewCircuits(numBoxes)
+
+	// NOTE: took me 3 hours to notice this part in the
+	// puzzle description *sigh*
+	for connIdx := 0; connIdx < limit; connIdx++ {
+
+		dist, ok := pairs.Next()
+		if !ok {
+			break
+		}
+
+		circuits.Connect(dist.b1Idx, dist.b2Idx)
+	}
+
+	return circuits.List()
+}
+
+// Circuits keeps track of which junction box is in which circuit.
+// It's a disjoint-set with path compression and union by size, so
+// finding and connecting are practically constant time.
+type Circuits struct {
+	parent []int
+	size   []int
+	count  int
+}
+
+// NewCircuits creates the circuits with every box in its own one.
+func NewCircuits(numBoxes int) *Circuits {
+	cs := &Circuits{
+		parent: make([]int, numBoxes),
+		size:   make([]int, numBoxes),
+		count:  numBoxes,
+	}
+	for i := 0; i < numBoxes; i++ {
+		cs.parent[i] = i
+		cs.size[i] = 1
+	}
+	return cs
+}
+
+// Find returns the representative box of the circuit containing 'box'.
+func (cs *Circuits) Find(box int) int {
+
+	root := box
+	for cs.parent[root] != root {
+		root = cs.parent[root]
+	}
+
+	// point everything on the way directly to the root
+	for cs.parent[box] != root {
+		next := cs.parent[box]
+		cs.parent[box] = root
+		box = next
+	}
+
+	return root
+}
+
+// Connect merges the circuits of the two boxes.
+// Returns false if they were already in the same circuit.
+func (cs *Circuits) Connect(box1, box2 int) bool {
+
+	root1 := cs.Find(box1)
+	root2 := cs.Find(box2)
+	if root1 == root2 {
+		return false
+	}
+
+	// hang the smaller one under the bigger one to keep the trees flat
+	if cs.size[root1] < cs.size[root2] {
+		root1, root2 = root2, root1
+	}
+	cs.parent[root2] = root1
+	cs.size[root1] += cs.size[root2]
+	cs.count--
+
+	return true
+}
+
+// Count returns the number of separate circuits.
+func (cs *Circuits) Count() int {
+	return cs.count
+}
+
+// List returns every separate circuit.
+func (cs *Circuits) List() []*Circuit {
+	circuits := make([]*Circuit, 0, cs.count)
+	for box := range cs.parent {
+		if cs.parent[box] == box {
+			circuits = append(circuits, &Circuit{set: cs, root: box})
+		}
+	}
+	return circuits
+}
+
+// Circuit is a view of one circuit in Circuits.
+// NOTE: it is only valid until the next Connect.
+type Circuit struct {
+	set  *Circuits
+	root int
+}
+
+func (c Circuit) Contains(idx int) bool {
+	return c.set.Find(idx) == c.root
+}
+
+func (c Circuit) Count() int {
+	return c.set.size[c.root]
+}
+
+func (c Circuit) Members() []int {
+	temp := make([]int, 0, c.Count())
+	for box := range c.set.parent {
+		if c.set.Find(box) == c.root {
+			temp = append(temp, box)
+		}
+	}
+	return temp
+}
+
+func (c Circuit) Print() {
+	for _, val := range c.Members() {
+		fmt.Printf("%d, ", val)
+	}
+	fmt.Println()
+}
+
+type Distance struct {
+	b1Idx, b2Idx int
+	value        int
+}
+
+func readCoords(lines []string) ([]Coords, error) {
+
+	coords := make([]Coords, len(lines))
+	for lineIdx, line := range lines {
+
+		nums := strings.Split(line, ",")
+		if len(nums) != 3 {
+			return nil, fmt.Errorf("malformed input line at %d (%s)", lineIdx, line)
+		}
+
+		x, err := strconv.Atoi(nums[0])
+		if err != nil {
+			return nil, fmt.Errorf("invalid number in line %d (%s)", lineIdx, nums[0])
+		}
+
+		y, err := strconv.Atoi(nums[1])
+		if err != nil {
+			return nil, fmt.Errorf("invalid number in line %d (%s)", lineIdx, nums[1])
+		}
+
+		z, err := strconv.Atoi(nums[2])
+		if err != nil {
+			return nil, fmt.Errorf("invalid number in line %d (%s)", lineIdx, nums[2])
+		}
+
+		coords[lineIdx] = Coords{x, y, z}
+	}
+
+	return coords, nil
+}
+
+type Coords struct {
+	x, y, z int
+}
+
+func distanceSquared(box1, box2 Coords) int {
+	return (box1.x-box2.x)*(box1.x-box2.x) + (box1.y-box2.y)*(box1.y-box2.y) + (box1.z-box2.z)*(box1.z-box2.z)
+}
@@ -0,0 +1,84 @@
+package day08
+
+import (
+	"math/rand"
+	"testing"
+)
+
+// randomBoxes gives 'count' boxes spread over 'clusters' cubes with
+// 'size' long sides, the cubes 'gap' apart along the x axis.
+func randomBoxes(rnd *rand.Rand, count, clusters, size, gap int) []Coords {
+	coords := make([]Coords, count)
+	for i := range coords {
+		offset := (i % clusters) * gap
+		coords[i] = Coords{offset + rnd.Intn(size), rnd.Intn(size), rnd.Intn(size)}
+	}
+	return coords
+}
+
+// kruskal is the straightforward part 2: connect the closest pairs in order.
+func kruskal(coords []Coords) Distance {
+
+	pairs := NewPairStream(coords)
+	circuits := NewCircuits(len(coords))
+
+	var lastConn Distance
+	for circuits.Count() > 1 {
+		dist, ok := pairs.Next()
+		if !ok {
+			break
+		}
+		if circuits.Connect(dist.b1Idx, dist.b2Idx) {
+			lastConn = dist
+		}
+	}
+
+	return lastConn
+}
+
+func TestLetsMakeContactP2MatchesKruskal(t *testing.T) {
+
+	rnd := rand.New(rand.NewSource(8))
+
+	for round := 0; round < 300; round++ {
+		count := 2 + rnd.Intn(150)
+		clusters := 1 + rnd.Intn(4)
+		// the small cubes have lots of equal distances for the tie breaking
+		size := []int{5, 100, 10000}[round%3]
+		coords := randomBoxes(rnd, count, clusters, size, 100000)
+
+		got := letsMakeContactP2(coords)
+		expected := kruskal(coords)
+		if got != expected {
+			t.Fatalf("round %d (%d boxes, %d clusters): got %v, expected %v", round, count, clusters, got, expected)
+		}
+	}
+}
+
+func BenchmarkLetsMakeContactP2(b *testing.B) {
+
+	for _, bench := range []struct {
+		name     string
+		clusters int
+	}{
+		{"uniform", 1},
+		{"clustered", 2},
+	} {
+		b.Run(bench.name, func(b *testing.B) {
+			coords := randomBoxes(rand.New(rand.NewSource(6000)), 6000, bench.clusters, 10000, 90000)
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				letsMakeContactP2(coords)
+			}
+		})
+	}
+}
+
+func TestPart2TooFewBoxes(t *testing.T) {
+
+	for _, lines := range [][]string{{}, {"1,2,3"}} {
+		if _, err := (Day{}).Part2(lines); err == nil {
+			t.Errorf("%v: expected an error", lines)
+		}
+	}
+}
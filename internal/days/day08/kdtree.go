@@ -0,0 +1,319 @@
+package day08
+
+import (
+	"container/heap"
+	"sort"
+)
+
+// KDTree is a 3 dimensional k-d tree over the junction boxes
+// for finding the nearest neighbours of a box.
+type KDTree struct {
+	coords []Coords
+	// box indices as an implicit balanced tree - the root of any
+	// [lo, hi) range is at the middle, the subtrees on the two sides
+	nodes []int
+	// the bounding box of the subtree under every node
+	lower, upper []Coords
+}
+
+// NewKDTree builds the tree. O(n log² n)
+func NewKDTree(coords []Coords) *KDTree {
+
+	tree := &KDTree{
+		coords: coords,
+		nodes:  make([]int, len(coords)),
+		lower:  make([]Coords, len(coords)),
+		upper:  make([]Coords, len(coords)),
+	}
+	for i := range tree.nodes {
+		tree.nodes[i] = i
+	}
+
+	tree.build(0, len(tree.nodes), 0)
+
+	return tree
+}
+
+func (t *KDTree) build(lo, hi, depth int) {
+
+	if lo >= hi {
+		return
+	}
+
+	axis := depth % 3
+	sub := t.nodes[lo:hi]
+	sort.Slice(sub, func(i, j int) bool {
+		return axisValue(t.coords[sub[i]], axis) < axisValue(t.coords[sub[j]], axis)
+	})
+
+	mid := (lo + hi) / 2
+	t.build(lo, mid, depth+1)
+	t.build(mid+1, hi, depth+1)
+
+	lower, upper := t.coords[t.nodes[mid]], t.coords[t.nodes[mid]]
+	for _, child := range [2][2]int{{lo, mid}, {mid + 1, hi}} {
+		if child[0] < child[1] {
+			childMid := (child[0] + child[1]) / 2
+			lower = minCoords(lower, t.lower[childMid])
+			upper = maxCoords(upper, t.upper[childMid])
+		}
+	}
+	t.lower[mid], t.upper[mid] = lower, upper
+}
+
+// Nearest returns the 'k' closest boxes to the box at 'idx' - not counting
+// itself - in increasing order of distance. Ties are ordered by box index,
+// so asking for more neighbours later gives the same list just longer.
+func (t *KDTree) Nearest(idx, k int) []Distance {
+
+	found := &distanceHeap{reversed: true}
+	t.search(0, len(t.nodes), 0, idx, k, found)
+
+	nearest := make([]Distance, found.Len())
+	for i := len(nearest) - 1; i >= 0; i-- {
+		nearest[i] = heap.Pop(found).(Distance)
+	}
+
+	return nearest
+}
+
+func (t *KDTree) search(lo, hi, depth, idx, k int, found *distanceHeap) {
+
+	if lo >= hi {
+		return
+	}
+
+	mid := (lo + hi) / 2
+	nodeIdx := t.nodes[mid]
+
+	if nodeIdx != idx {
+		dist := Distance{idx, nodeIdx, distanceSquared(t.coords[idx], t.coords[nodeIdx])}
+		if found.Len() < k {
+			heap.Push(found, dist)
+		} else if dist.Less(found.items[0]) {
+			found.items[0] = dist
+			heap.Fix(found, 0)
+		}
+	}
+
+	// the side the box is on first, the other only if it can be any closer
+	axis := depth % 3
+	diff := axisValue(t.coords[idx], axis) - axisValue(t.coords[nodeIdx], axis)
+	nearLo, nearHi, farLo, farHi := lo, mid, mid+1, hi
+	if diff > 0 {
+		nearLo, nearHi, farLo, farHi = mid+1, hi, lo, mid
+	}
+
+	t.search(nearLo, nearHi, depth+1, idx, k, found)
+	if found.Len() < k || diff*diff <= found.items[0].value {
+		t.search(farLo, farHi, depth+1, idx, k, found)
+	}
+}
+
+// mixedCircuits labels a subtree with boxes from more than one circuit.
+const mixedCircuits = -1
+
+// CircuitLabels returns for every node of the tree the circuit all the
+// boxes in its subtree belong to, or mixedCircuits. 'circuitOf' gives the
+// circuit of every box. O(n)
+func (t *KDTree) CircuitLabels(circuitOf []int) []int {
+
+	labels := make([]int, len(t.nodes))
+	if len(labels) > 0 {
+		t.label(0, len(t.nodes), circuitOf, labels)
+	}
+
+	return labels
+}
+
+func (t *KDTree) label(lo, hi int, circuitOf, labels []int) {
+
+	mid := (lo + hi) / 2
+	own := circuitOf[t.nodes[mid]]
+	labels[mid] = own
+
+	for _, sub := range [2][2]int{{lo, mid}, {mid + 1, hi}} {
+		if sub[0] >= sub[1] {
+			continue
+		}
+		t.label(sub[0], sub[1], circuitOf, labels)
+		if labels[(sub[0]+sub[1])/2] != own {
+			labels[mid] = mixedCircuits
+		}
+	}
+}
+
+// NearestOutside returns the closest box to the box at 'idx' which is in
+// another circuit, if it's closer than 'best' - otherwise 'best'. Subtrees
+// with only boxes from the same circuit are skipped whole, and so is
+// everything further than 'best', so passing in the closest one found for
+// the circuit so far saves most of the work. The boxes in the result are
+// in increasing index order, like the ones from PairStream.
+func (t *KDTree) NearestOutside(idx int, circuitOf, labels []int, best Distance) Distance {
+
+	t.searchOutside(0, len(t.nodes), 0, idx, circuitOf, labels, &best)
+
+	return best
+}
+
+func (t *KDTree) searchOutside(lo, hi, depth, idx int, circuitOf, labels []int, best *Distance) {
+
+	if lo >= hi {
+		return
+	}
+
+	mid := (lo + hi) / 2
+	own := circuitOf[idx]
+	if labels[mid] == own || t.boundsDistance(idx, mid) > best.value {
+		return
+	}
+
+	nodeIdx := t.nodes[mid]
+	if circuitOf[nodeIdx] != own {
+		dist := Distance{min(idx, nodeIdx), max(idx, nodeIdx), distanceSquared(t.coords[idx], t.coords[nodeIdx])}
+		if dist.Less(*best) {
+			*best = dist
+		}
+	}
+
+	axis := depth % 3
+	diff := axisValue(t.coords[idx], axis) - axisValue(t.coords[nodeIdx], axis)
+	nearLo, nearHi, farLo, farHi := lo, mid, mid+1, hi
+	if diff > 0 {
+		nearLo, nearHi, farLo, farHi = mid+1, hi, lo, mid
+	}
+
+	t.searchOutside(nearLo, nearHi, depth+1, idx, circuitOf, labels, best)
+	if diff*diff <= best.value {
+		t.searchOutside(farLo, farHi, depth+1, idx, circuitOf, labels, best)
+	}
+}
+
+// boundsDistance returns the squared distance of the box at 'idx' from the
+// bounding box of the subtree under the node at 'pos', so nothing in that
+// subtree can be any closer.
+func (t *KDTree) boundsDistance(idx, pos int) int {
+
+	c := t.coords[idx]
+	closest := maxCoords(t.lower[pos], minCoords(c, t.upper[pos]))
+
+	return distanceSquared(c, closest)
+}
+
+func minCoords(c1, c2 Coords) Coords {
+	return Coords{min(c1.x, c2.x), min(c1.y, c2.y), min(c1.z, c2.z)}
+}
+
+func maxCoords(c1, c2 Coords) Coords {
+	return Coords{max(c1.x, c2.x), max(c1.y, c2.y), max(c1.z, c2.z)}
+}
+
+func axisValue(c Coords, axis int) int {
+	switch axis {
+	case 0:
+		return c.x
+	case 1:
+		return c.y
+	default:
+		return c.z
+	}
+}
+
+// PairStream gives every pair of boxes once, closest first, without
+// calculating all n*(n-1)/2 distances up front.
+//
+// Every box has a list of its nearest neighbours from the tree, which
+// is doubled in length when it runs out. The heads of these sorted lists
+// are merged with a heap, so memory only grows with how far we go.
+type PairStream struct {
+	tree       *KDTree
+	neighbours [][]Distance
+	nextIdx    []int
+	heads      *distanceHeap
+}
+
+const initialNeighbourCount = 8
+
+// NewPairStream creates the stream over the boxes.
+func NewPairStream(coords []Coords) *PairStream {
+
+	ps := &PairStream{
+		tree:       NewKDTree(coords),
+		neighbours: make([][]Distance, len(coords)),
+		nextIdx:    make([]int, len(coords)),
+		heads:      &distanceHeap{items: make([]Distance, 0, len(coords))},
+	}
+
+	for box := range coords {
+		ps.neighbours[box] = ps.tree.Nearest(box, initialNeighbourCount)
+		ps.advance(box)
+	}
+
+	return ps
+}
+
+// Next returns the next closest pair, or false if there are no more.
+func (ps *PairStream) Next() (Distance, bool) {
+
+	for ps.heads.Len() > 0 {
+		dist := heap.Pop(ps.heads).(Distance)
+		ps.advance(dist.b1Idx)
+
+		// every pair is in both boxes' list, only give it out once
+		if dist.b1Idx < dist.b2Idx {
+			return dist, true
+		}
+	}
+
+	return Distance{}, false
+}
+
+// advance puts the next neighbour of 'box' into the heap,
+// fetching more neighbours from the tree when needed.
+func (ps *PairStream) advance(box int) {
+
+	list := ps.neighbours[box]
+	if ps.nextIdx[box] == len(list) {
+		if len(list) == len(ps.neighbours)-1 {
+			return // already have every other box
+		}
+		list = ps.tree.Nearest(box, 2*len(list))
+		ps.neighbours[box] = list
+	}
+
+	heap.Push(ps.heads, list[ps.nextIdx[box]])
+	ps.nextIdx[box]++
+}
+
+// Less orders the distances by value, then by the box indices
+// so the order is always the same.
+func (d Distance) Less(other Distance) bool {
+	if d.value != other.value {
+		return d.value < other.value
+	}
+	if d.b1Idx != other.b1Idx {
+		return d.b1Idx < other.b1Idx
+	}
+	return d.b2Idx < other.b2Idx
+}
+
+// distanceHeap is a min-heap of distances, or a max-heap if reversed.
+type distanceHeap struct {
+	items    []Distance
+	reversed bool
+}
+
+func (h distanceHeap) Len() int { return len(h.items) }
+func (h distanceHeap) Less(i, j int) bool {
+	if h.reversed {
+		return h.items[j].Less(h.items[i])
+	}
+	return h.items[i].Less(h.items[j])
+}
+func (h distanceHeap) Swap(i, j int) { h.items[i], h.items[j] = h.items[j], h.items[i] }
+func (h *distanceHeap) Push(x any)   { h.items = append(h.items, x.(Distance)) }
+func (h *distanceHeap) Pop() any {
+	last := h.items[len(h.items)-1]
+	h.items = h.items[:len(h.items)-1]
+	return last
+}
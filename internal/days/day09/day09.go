@@ -0,0 +1,381 @@
+package day09
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/rawbits2010/AoC25/internal/bignum"
+	"github.com/rawbits2010/AoC25/internal/inputhandler"
+	"github.com/rawbits2010/AoC25/internal/solution"
+)
+
+var selfCheck = inputhandler.Options.Bool("selfcheck", false, "run the brute force search for part 1 too and fail if the results differ")
+
+func init() {
+	solution.Register(9, Day{})
+}
+
+// Day is the solution for day 9.
+type Day struct{}
+
+func (Day) Part1(lines []string) (any, error) {
+
+	coords, err := parseCoords(lines)
+	if err != nil {
+		return nil, err
+	}
+
+	result := largestArea(coords)
+	if *selfCheck {
+		resultBruteForce := part1BruteForce(coords)
+		if result.Cmp(resultBruteForce) != 0 {
+			return nil, fmt.Errorf("self-check failed for part 1 - hull search: %s, brute force: %s", result, resultBruteForce)
+		}
+	}
+
+	return result, nil
+}
+
+func (Day) Part2(lines []string) (any, error) {
+
+	coords, err := parseCoords(lines)
+	if err != nil {
+		return nil, err
+	}
+
+	return largestInsideArea(coords)
+}
+
+//
+//-Part 2----
+
+// largestInsideArea finds the largest rectangle with red tile corners which
+// fits inside the loop drawn by the red tiles in the order they are listed.
+//
+// The coordinates are compressed, so every distinct x and y gets a grid
+// cell, with an extra cell for the gap between each neighbouring pair and
+// a padding around. That way the grid size depends only on the number of
+// red tiles. Then the outside is flood filled and a 2D prefix sum over it
+// tells in O(1) if a rectangle has any outside tile in it.
+func largestInsideArea(coords []Coords) (bignum.Int, error) {
+
+	xs := uniqueSorted(coords, func(c Coords) bignum.Int { return c.x })
+	ys := uniqueSorted(coords, func(c Coords) bignum.Int { return c.y })
+
+	// coordinate idx i goes to cell 2*i+1, the gaps to the even cells
+	width := 2*len(xs) + 1
+	height := 2*len(ys) + 1
+	toCell := func(c Coords) (int, int) {
+		return 2*indexOf(xs, c.x) + 1, 2*indexOf(ys, c.y) + 1
+	}
+
+	grid := make([][]Tile, height)
+	for y := range grid {
+		grid[y] = make([]Tile, width)
+	}
+
+	// draw the loop
+	for i := 0; i < len(coords); i++ {
+		from := coords[i]
+		to := coords[(i+1)%len(coords)]
+		if from.x.Cmp(to.x) != 0 && from.y.Cmp(to.y) != 0 {
+			return bignum.Int{}, fmt.Errorf("red tiles at %d and %d are not in the same row or column", i, (i+1)%len(coords))
+		}
+
+		fromX, fromY := toCell(from)
+		toX, toY := toCell(to)
+		for y := min(fromY, toY); y <= max(fromY, toY); y++ {
+			for x := min(fromX, toX); x <= max(fromX, toX); x++ {
+				grid[y][x] = Border
+			}
+		}
+	}
+
+	floodOutside(grid)
+
+	// a gap cell between neighbouring coordinates has no tiles in it,
+	// so it mustn't count even if the flood reached it
+	xHasTiles := gapHasTiles(xs)
+	yHasTiles := gapHasTiles(ys)
+
+	// outsideSum[y][x] is the number of outside cells above and left of (x, y)
+	outsideSum := make([][]int, height+1)
+	outsideSum[0] = make([]int, width+1)
+	for y := 0; y < height; y++ {
+		outsideSum[y+1] = make([]int, width+1)
+		for x := 0; x < width; x++ {
+			count := 0
+			if grid[y][x] == Outside && xHasTiles[x] && yHasTiles[y] {
+				count = 1
+			}
+			outsideSum[y+1][x+1] = count + outsideSum[y][x+1] + outsideSum[y+1][x] - outsideSum[y][x]
+		}
+	}
+
+	var areaMax bignum.Int
+	lastI := 0
+	lastJ := 0
+	for i := 0; i < len(coords); i++ {
+		for j := i + 1; j < len(coords); j++ {
+
+			x1, y1 := toCell(coords[i])
+			x2, y2 := toCell(coords[j])
+			x1, x2 = min(x1, x2), max(x1, x2)
+			y1, y2 = min(y1, y2), max(y1, y2)
+
+			outsideCount := outsideSum[y2+1][x2+1] - outsideSum[y1][x2+1] - outsideSum[y2+1][x1] + outsideSum[y1][x1]
+			if outsideCount != 0 {
+				continue
+			}
+
+			area := calcArea(coords[i].x, coords[i].y, coords[j].x, coords[j].y)
+			if areaMax.Cmp(area) < 0 {
+				areaMax = area
+				lastI = i
+				lastJ = j
+			}
+		}
+	}
+
+	if inputhandler.Verbose() {
+		fmt.Println("Point 1 - X: ", coords[lastI].x, ", Y: ", coords[lastI].y)
+		fmt.Println("Point 2 - X: ", coords[lastJ].x, ", Y: ", coords[lastJ].y)
+	}
+
+	return areaMax, nil
+}
+
+type Tile byte
+
+const (
+	Inside  Tile = 0
+	Border  Tile = 1
+	Outside Tile = 2
+)
+
+// floodOutside marks every cell reachable from the padding without
+// crossing the border as Outside. Everything else is inside the loop.
+func floodOutside(grid [][]Tile) {
+
+	type cell struct{ x, y int }
+
+	grid[0][0] = Outside
+	queue := []cell{{0, 0}}
+	for len(queue) > 0 {
+		curr := queue[len(queue)-1]
+		queue = queue[:len(queue)-1]
+
+		for _, next := range []cell{{curr.x - 1, curr.y}, {curr.x + 1, curr.y}, {curr.x, curr.y - 1}, {curr.x, curr.y + 1}} {
+			if next.y < 0 || next.y >= len(grid) || next.x < 0 || next.x >= len(grid[next.y]) {
+				continue
+			}
+			if grid[next.y][next.x] != Inside {
+				continue
+			}
+			grid[next.y][next.x] = Outside
+			queue = append(queue, next)
+		}
+	}
+}
+
+// gapHasTiles tells for every compressed cell if it stands for any actual tiles.
+func gapHasTiles(vals []bignum.Int) []bool {
+
+	hasTiles := make([]bool, 2*len(vals)+1)
+	for i := range hasTiles {
+		hasTiles[i] = true
+	}
+	for i := 0; i+1 < len(vals); i++ {
+		hasTiles[2*i+2] = vals[i+1].Sub(vals[i]).Cmp(one) > 0
+	}
+
+	return hasTiles
+}
+
+func uniqueSorted(coords []Coords, getFn func(Coords) bignum.Int) []bignum.Int {
+
+	vals := make([]bignum.Int, len(coords))
+	for i, c := range coords {
+		vals[i] = getFn(c)
+	}
+	sort.Slice(vals, func(i, j int) bool {
+		return vals[i].Cmp(vals[j]) < 0
+	})
+
+	unique := vals[:0]
+	for i, val := range vals {
+		if i == 0 || val.Cmp(unique[len(unique)-1]) != 0 {
+			unique = append(unique, val)
+		}
+	}
+
+	return unique
+}
+
+// indexOf finds 'val' in the sorted 'vals'. It has to be there!
+func indexOf(vals []bignum.Int, val bignum.Int) int {
+	return sort.Search(len(vals), func(i int) bool {
+		return vals[i].Cmp(val) >= 0
+	})
+}
+
+//
+//-Part 1----
+
+func parseCoords(lines []string) ([]Coords, error) {
+
+	if len(lines) == 0 {
+		return nil, fmt.Errorf("no data provided")
+	}
+
+	coords := make([]Coords, len(lines))
+	for i := 0; i < len(lines); i++ {
+
+		x, y, err := getCoords(lines[i])
+		if err != nil {
+			return nil, fmt.Errorf("error parsing line at %d (%s) :%w", i, lines[i], err)
+		}
+
+		coords[i] = Coords{x, y}
+	}
+
+	return coords, nil
+}
+
+func getCoords(line string) (bignum.Int, bignum.Int, error) {
+	nums := strings.Split(line, ",")
+	if len(nums) != 2 {
+		return bignum.Int{}, bignum.Int{}, fmt.Errorf("invalid format")
+	}
+	x, err := bignum.Parse(nums[0])
+	if err != nil {
+		return bignum.Int{}, bignum.Int{}, err
+	}
+	y, err := bignum.Parse(nums[1])
+	if err != nil {
+		return bignum.Int{}, bignum.Int{}, err
+	}
+	return x, y, nil
+}
+
+type Coords struct {
+	x, y bignum.Int
+}
+
+func part1BruteForce(coords []Coords) bignum.Int {
+	var areaMax bignum.Int
+	lastI := 0
+	lastJ := 0
+	for i := 0; i < len(coords); i++ {
+		for j := i; j < len(coords); j++ {
+			first := coords[i]
+			second := coords[j]
+
+			area := calcArea(first.x, first.y, second.x, second.y)
+			if areaMax.Cmp(area) < 0 {
+				areaMax = area
+				lastI = i
+				lastJ = j
+			}
+		}
+	}
+
+	if inputhandler.Verbose() {
+		fmt.Println("Point 1 - X: ", coords[lastI].x, ", Y: ", coords[lastI].y)
+		fmt.Println("Point 2 - X: ", coords[lastJ].x, ", Y: ", coords[lastJ].y)
+	}
+
+	return areaMax
+}
+
+// largestArea only checks the red tiles on the orthogonal convex hull.
+//
+// If a rectangle has its top-left corner at 'p' and there is a red
+// tile 'r' with r.x <= p.x and r.y <= p.y, moving that corner to 'r'
+// can't make the rectangle smaller. So there is always a largest
+// rectangle with its corners on the staircases of the not dominated
+// tiles - one staircase for each direction - which together make up
+// the orthogonal convex hull. Pairing the top-left staircase with
+// the bottom-right, and the top-right with the bottom-left one covers
+// every possible orientation, so this is exhaustive.
+//
+// NOTE: the plain convex hull is not enough! Tiles (0,60), (60,0) and
+// (100,100) make a hull where (31,31) is inside, but (31,31)-(100,100)
+// is the largest rectangle.
+func largestArea(coords []Coords) bignum.Int {
+
+	topLeft := staircase(coords, 1, 1)
+	bottomRight := staircase(coords, -1, -1)
+	topRight := staircase(coords, -1, 1)
+	bottomLeft := staircase(coords, 1, -1)
+
+	var areaMax bignum.Int
+	for _, sets := range [][2][]Coords{{topLeft, bottomRight}, {topRight, bottomLeft}} {
+		for _, first := range sets[0] {
+			for _, second := range sets[1] {
+
+				area := calcArea(first.x, first.y, second.x, second.y)
+				if areaMax.Cmp(area) < 0 {
+					areaMax = area
+				}
+			}
+		}
+	}
+
+	return areaMax
+}
+
+// calcArea returns the number of tiles in the rectangle with the given
+// opposite corners. The corners can be given in any order.
+func calcArea(x1, y1, x2, y2 bignum.Int) bignum.Int {
+	xDiff := x2.Sub(x1).Abs()
+	xDiff = xDiff.Inc()
+	yDiff := y2.Sub(y1).Abs()
+	yDiff = yDiff.Inc()
+	return xDiff.Mul(yDiff)
+}
+
+// staircase returns the tiles not dominated by any other tile from the given
+// direction, where 1 means from the lower and -1 from the higher values.
+// So (1, 1) finds the tiles with no other tile both left and above them.
+func staircase(coords []Coords, xDir, yDir int) []Coords {
+
+	type keyed struct {
+		key    Coords
+		coords Coords
+	}
+
+	// flip the axes so the job is always to find the top-left staircase
+	flip := func(val bignum.Int, dir int) bignum.Int {
+		if dir < 0 {
+			return val.Neg()
+		}
+		return val
+	}
+
+	sorted := make([]keyed, len(coords))
+	for i, c := range coords {
+		sorted[i] = keyed{Coords{flip(c.x, xDir), flip(c.y, yDir)}, c}
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		if cmp := sorted[i].key.x.Cmp(sorted[j].key.x); cmp != 0 {
+			return cmp < 0
+		}
+		return sorted[i].key.y.Cmp(sorted[j].key.y) < 0
+	})
+
+	// going right, a tile is only on the staircase if it's higher than all before
+	steps := make([]Coords, 0, len(sorted))
+	var yMin bignum.Int
+	for i, tile := range sorted {
+		if i == 0 || tile.key.y.Cmp(yMin) < 0 {
+			steps = append(steps, tile.coords)
+			yMin = tile.key.y
+		}
+	}
+
+	return steps
+}
+
+var one = bignum.NewUint(1)
@@ -0,0 +1,59 @@
+package day09
+
+import (
+	"math/rand"
+	"strings"
+	"testing"
+
+	"github.com/rawbits2010/AoC25/internal/bignum"
+)
+
+// randomCoords gives count tiles with x in [minX, maxX] and y in [minY, maxY].
+func randomCoords(rnd *rand.Rand, count int, minX, maxX, minY, maxY int64) []Coords {
+	coords := make([]Coords, count)
+	for i := range coords {
+		x := minX + rnd.Int63n(maxX-minX+1)
+		y := minY + rnd.Int63n(maxY-minY+1)
+		coords[i] = Coords{bignum.NewInt(x), bignum.NewInt(y)}
+	}
+	return coords
+}
+
+// formatCoords writes the tiles the way the input lists them.
+func formatCoords(coords []Coords) string {
+	lines := make([]string, len(coords))
+	for i, c := range coords {
+		lines[i] = c.x.String() + "," + c.y.String()
+	}
+	return strings.Join(lines, ";")
+}
+
+func TestLargestAreaMatchesBruteForce(t *testing.T) {
+
+	rnd := rand.New(rand.NewSource(9))
+
+	// bounds as minX, maxX, minY, maxY - the one sided and degenerate ones
+	// leave some of the staircases with nothing in them
+	bounds := [][4]int64{
+		{-20, 20, -20, 20},
+		{-1000, 1000, -1000, 1000},
+		{1, 50, 1, 50},
+		{-50, -1, -50, -1},
+		{-50, -1, 1, 50},
+		{0, 0, -30, 30},
+		{-30, 30, 7, 7},
+		{-3, 3, -3, 3},
+	}
+
+	for round := 0; round < 3000; round++ {
+		b := bounds[round%len(bounds)]
+		count := 1 + rnd.Intn(40)
+		coords := randomCoords(rnd, count, b[0], b[1], b[2], b[3])
+
+		got := largestArea(coords)
+		expected := part1BruteForce(coords)
+		if got.Cmp(expected) != 0 {
+			t.Fatalf("round %d: largestArea gave %s, brute force %s for %s", round, got, expected, formatCoords(coords))
+		}
+	}
+}
@@ -0,0 +1,16 @@
+// days package is the collection of the daily solutions.
+// Import it for the side effect of registering all of them,
+// then look them up with solution.Get.
+package days
+
+import (
+	_ "github.com/rawbits2010/AoC25/internal/days/day01"
+	_ "github.com/rawbits2010/AoC25/internal/days/day02"
+	_ "github.com/rawbits2010/AoC25/internal/days/day03"
+	_ "github.com/rawbits2010/AoC25/internal/days/day04"
+	_ "github.com/rawbits2010/AoC25/internal/days/day05"
+	_ "github.com/rawbits2010/AoC25/internal/days/day06"
+	_ "github.com/rawbits2010/AoC25/internal/days/day07"
+	_ "github.com/rawbits2010/AoC25/internal/days/day08"
+	_ "github.com/rawbits2010/AoC25/internal/days/day09"
+)
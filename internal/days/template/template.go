@@ -0,0 +1,27 @@
+package template
+
+import (
+	"github.com/rawbits2010/AoC25/internal/solution"
+)
+
+// NOTE: rename the package to the day, set the day number below,
+// and add the package to the imports in internal/days.
+func init() {
+	// solution.Register(NN, Day{})
+}
+
+// Day is the solution for day NN.
+type Day struct{}
+
+func (Day) Part1(lines []string) (any, error) {
+
+	var result int
+	// do something with input lines
+	_ = lines
+
+	return result, nil
+}
+
+func (Day) Part2(lines []string) (any, error) {
+	return nil, solution.ErrNotImplemented
+}
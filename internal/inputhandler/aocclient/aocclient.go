@@ -0,0 +1,187 @@
+// aocclient package submits the puzzle answers to the Advent of Code site,
+// using the session found by inputhandler.
+//
+// Every submission is recorded in a local history, and an answer already
+// judged is never sent again - the site makes you wait longer after every
+// wrong answer, so the verdict from the history is returned instead.
+package aocclient
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/rawbits2010/AoC25/internal/inputhandler"
+)
+
+// Verdict is what the site said about the answer.
+type Verdict string
+
+const (
+	Correct       Verdict = "correct"
+	Wrong         Verdict = "wrong"
+	TooHigh       Verdict = "too high"
+	TooLow        Verdict = "too low"
+	RateLimited   Verdict = "rate limited"
+	AlreadySolved Verdict = "already solved"
+	Unknown       Verdict = "unknown"
+)
+
+// IsFinal reports whether the verdict is about the answer itself,
+// so sending it again would give the same.
+func (v Verdict) IsFinal() bool {
+	switch v {
+	case Correct, Wrong, TooHigh, TooLow:
+		return true
+	}
+	return false
+}
+
+// ErrUnknownResponse is returned when the verdict couldn't be found in the answer page.
+var ErrUnknownResponse = errors.New("unrecognized answer page")
+
+// Result of a submission.
+type Result struct {
+	Verdict Verdict
+	// Wait is how long to wait before the next answer, if the site said so
+	Wait time.Duration
+	// Message is the text of the answer page
+	Message string
+	// FromHistory is set when the answer was not sent,
+	// because it was already judged before
+	FromHistory bool
+}
+
+// Client submits the answers.
+type Client struct {
+	// BaseURL is the site, the submissions go to BaseURL/year/day/N/answer
+	BaseURL string
+	Web     *inputhandler.WebClient
+	History *History
+}
+
+// New returns a Client for the AoC site, with the options of
+// inputhandler and the history in the default location.
+func New() (*Client, error) {
+
+	path, err := DefaultHistoryPath()
+	if err != nil {
+		return nil, err
+	}
+
+	return &Client{
+		BaseURL: inputhandler.BaseURL,
+		Web:     inputhandler.DefaultWebClient(),
+		History: &History{Path: path},
+	}, nil
+}
+
+// Submit sends the answer of the part of the puzzle, and returns the verdict.
+// If the same answer was already judged, it is not sent again.
+func (c *Client) Submit(year, day, part int, answer string) (Result, error) {
+
+	if part != 1 && part != 2 {
+		return Result{}, fmt.Errorf("there is no part %d", part)
+	}
+	answer = strings.TrimSpace(answer)
+	if answer == "" {
+		return Result{}, fmt.Errorf("empty answer")
+	}
+
+	previous, found, err := c.History.Find(year, day, part, answer)
+	if err != nil {
+		return Result{}, err
+	}
+	if found {
+		return Result{
+			Verdict:     previous.Verdict,
+			Message:     fmt.Sprintf("Already sent at %s.", previous.Time.Format("2006-01-02 15:04")),
+			FromHistory: true,
+		}, nil
+	}
+
+	address := fmt.Sprintf("%s/%d/day/%d/answer", c.BaseURL, year, day)
+	form := url.Values{
+		"level":  {strconv.Itoa(part)},
+		"answer": {answer},
+	}
+
+	page, err := c.Web.PostForm(address, form)
+	if err != nil {
+		return Result{}, err
+	}
+
+	result := ParseResponse(page)
+
+	err = c.History.Add(Submission{
+		Year:    year,
+		Day:     day,
+		Part:    part,
+		Answer:  answer,
+		Verdict: result.Verdict,
+		Time:    time.Now(),
+	})
+	if err != nil {
+		return result, fmt.Errorf("error recording the submission: %w", err)
+	}
+
+	if result.Verdict == Unknown {
+		return result, fmt.Errorf("%w: %s", ErrUnknownResponse, result.Message)
+	}
+
+	return result, nil
+}
+
+// verdictTexts are the parts of the answer pages telling the verdict.
+// The order matters, the more specific ones are first.
+var verdictTexts = []struct {
+	text    string
+	verdict Verdict
+}{
+	{"That's the right answer", Correct},
+	{"your answer is too high", TooHigh},
+	{"your answer is too low", TooLow},
+	{"That's not the right answer", Wrong},
+	{"You gave an answer too recently", RateLimited},
+	{"You don't seem to be solving the right level", AlreadySolved},
+}
+
+var articleRegexp = regexp.MustCompile(`(?s)<article[^>]*>(.*?)</article>`)
+var tagRegexp = regexp.MustCompile(`<[^>]*>`)
+var leftToWaitRegexp = regexp.MustCompile(`You have ((?:\d+h )?(?:\d+m )?\d+s) left to wait`)
+var waitMinutesRegexp = regexp.MustCompile(`(?i)please wait (one|\d+) minutes? before trying again`)
+
+// ParseResponse finds the verdict in the answer page.
+func ParseResponse(page string) Result {
+
+	// the verdict is in the only article, the rest is the site
+	message := page
+	if match := articleRegexp.FindStringSubmatch(page); match != nil {
+		message = match[1]
+	}
+	message = strings.Join(strings.Fields(tagRegexp.ReplaceAllString(message, "")), " ")
+
+	result := Result{Verdict: Unknown, Message: message}
+	for _, v := range verdictTexts {
+		if strings.Contains(message, v.text) {
+			result.Verdict = v.verdict
+			break
+		}
+	}
+
+	if match := leftToWaitRegexp.FindStringSubmatch(message); match != nil {
+		result.Wait, _ = time.ParseDuration(strings.ReplaceAll(match[1], " ", ""))
+	} else if match := waitMinutesRegexp.FindStringSubmatch(message); match != nil {
+		minutes := 1
+		if match[1] != "one" {
+			minutes, _ = strconv.Atoi(match[1])
+		}
+		result.Wait = time.Duration(minutes) * time.Minute
+	}
+
+	return result
+}
@@ -0,0 +1,162 @@
+package aocclient
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/rawbits2010/AoC25/internal/inputhandler"
+)
+
+const testSessionEnvVar = "AOCCLIENT_TEST_SESSION"
+
+func answerPage(text string) string {
+	return `<html><body><header>Advent of Code</header><main>
+<article><p>` + text + `</p></article>
+</main></body></html>`
+}
+
+// testServer stands in for the site, answering every POST with the page.
+func testServer(t *testing.T, page string, hits *int) *Client {
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*hits++
+
+		if r.Method != "POST" || r.URL.Path != "/2025/day/5/answer" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		if cookie, err := r.Cookie("session"); err != nil || cookie.Value != "secret" {
+			t.Errorf("missing session cookie")
+		}
+		if r.FormValue("level") != "1" || r.FormValue("answer") != "640" {
+			t.Errorf("unexpected form %v", r.Form)
+		}
+
+		w.Write([]byte(page))
+	}))
+	t.Cleanup(server.Close)
+
+	t.Setenv(testSessionEnvVar, "secret")
+
+	return &Client{
+		BaseURL: server.URL,
+		Web: &inputhandler.WebClient{
+			Timeout:     time.Second,
+			UserAgent:   inputhandler.UserAgent,
+			Credentials: &inputhandler.Credentials{EnvVar: testSessionEnvVar},
+		},
+		History: &History{Path: filepath.Join(t.TempDir(), "submissions.jsonl")},
+	}
+}
+
+func TestParseResponse(t *testing.T) {
+
+	cases := map[string]struct {
+		text    string
+		verdict Verdict
+		wait    time.Duration
+	}{
+		"correct":        {"That's the right answer! You are one gold star closer to decorating the North Pole.", Correct, 0},
+		"wrong":          {"That's not the right answer. If you're stuck, make sure you're using the full input data; please wait one minute before trying again.", Wrong, time.Minute},
+		"too high":       {"That's not the right answer; your answer is too high. Please wait 5 minutes before trying again.", TooHigh, 5 * time.Minute},
+		"too low":        {"That's not the right answer; your answer is too low. Please wait one minute before trying again.", TooLow, time.Minute},
+		"rate limited":   {"You gave an answer too recently; you have to wait after submitting an answer before trying again. You have 1m 23s left to wait.", RateLimited, 83 * time.Second},
+		"already solved": {"You don't seem to be solving the right level. Did you already complete it?", AlreadySolved, 0},
+		"unknown":        {"Something else entirely.", Unknown, 0},
+	}
+
+	for name, c := range cases {
+		result := ParseResponse(answerPage(c.text))
+		if result.Verdict != c.verdict || result.Wait != c.wait {
+			t.Errorf("%s: expected %s (%v), got %s (%v)", name, c.verdict, c.wait, result.Verdict, result.Wait)
+		}
+		if result.Message != c.text {
+			t.Errorf("%s: unexpected message %q", name, result.Message)
+		}
+	}
+}
+
+func TestSubmitNeverResendsWrongAnswer(t *testing.T) {
+
+	hits := 0
+	client := testServer(t, answerPage("That's not the right answer; your answer is too low."), &hits)
+
+	result, err := client.Submit(2025, 5, 1, "640")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Verdict != TooLow || result.FromHistory {
+		t.Errorf("expected fresh too low, got %+v", result)
+	}
+
+	result, err = client.Submit(2025, 5, 1, " 640\n")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Verdict != TooLow || !result.FromHistory {
+		t.Errorf("expected too low from history, got %+v", result)
+	}
+
+	if hits != 1 {
+		t.Errorf("the same answer was sent %d times", hits)
+	}
+}
+
+func TestSubmitResendsAfterRateLimit(t *testing.T) {
+
+	hits := 0
+	client := testServer(t, answerPage("You gave an answer too recently; You have 30s left to wait."), &hits)
+
+	for range 2 {
+		result, err := client.Submit(2025, 5, 1, "640")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if result.Verdict != RateLimited || result.Wait != 30*time.Second {
+			t.Errorf("expected rate limited for 30s, got %+v", result)
+		}
+	}
+
+	if hits != 2 {
+		t.Errorf("rate limited answers should be sent again, got %d hits", hits)
+	}
+
+	submissions, err := client.History.Load()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(submissions) != 2 {
+		t.Errorf("expected 2 recorded submissions, got %d", len(submissions))
+	}
+}
+
+func TestSubmitUnknownResponse(t *testing.T) {
+
+	hits := 0
+	client := testServer(t, "<html>maintenance</html>", &hits)
+
+	result, err := client.Submit(2025, 5, 1, "640")
+	if !errors.Is(err, ErrUnknownResponse) || result.Verdict != Unknown {
+		t.Errorf("expected ErrUnknownResponse, got %v (%s)", err, result.Verdict)
+	}
+}
+
+func TestSubmitWithoutSession(t *testing.T) {
+
+	client := &Client{
+		BaseURL: "http://127.0.0.1:0",
+		Web: &inputhandler.WebClient{
+			Credentials: &inputhandler.Credentials{EnvVar: testSessionEnvVar},
+		},
+		History: &History{Path: filepath.Join(t.TempDir(), "submissions.jsonl")},
+	}
+	t.Setenv(testSessionEnvVar, "")
+	t.Chdir(t.TempDir()) // no session.txt either
+
+	if _, err := client.Submit(2025, 5, 1, "640"); !errors.Is(err, inputhandler.ErrNoSession) {
+		t.Errorf("expected ErrNoSession, got %v", err)
+	}
+}
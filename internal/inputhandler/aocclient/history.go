@@ -0,0 +1,114 @@
+package aocclient
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// Submission is an answer sent, as recorded in the History.
+type Submission struct {
+	Year    int       `json:"year"`
+	Day     int       `json:"day"`
+	Part    int       `json:"part"`
+	Answer  string    `json:"answer"`
+	Verdict Verdict   `json:"verdict"`
+	Time    time.Time `json:"time"`
+}
+
+// History is the local record of the submissions,
+// a file with one JSON object per line.
+type History struct {
+	Path string
+}
+
+// DefaultHistoryPath returns the history file in the user config directory,
+// next to the session file - it is not something to clear like the cache.
+func DefaultHistoryPath() (string, error) {
+	configDir, err := os.UserConfigDir()
+	if err != nil {
+		return "", fmt.Errorf("error finding the config directory: %w", err)
+	}
+	return filepath.Join(configDir, "aoc25", "submissions.jsonl"), nil
+}
+
+// Load returns all the submissions, oldest first.
+// A missing file is an empty history.
+func (h *History) Load() ([]Submission, error) {
+
+	file, err := os.Open(h.Path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error opening submission history: %w", err)
+	}
+	defer file.Close()
+
+	var submissions []Submission
+	scanner := bufio.NewScanner(file)
+	for lineNum := 1; scanner.Scan(); lineNum++ {
+
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+
+		var s Submission
+		if err := json.Unmarshal(scanner.Bytes(), &s); err != nil {
+			return nil, fmt.Errorf("malformed submission history at line %d: %w", lineNum, err)
+		}
+		submissions = append(submissions, s)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("error reading submission history: %w", err)
+	}
+
+	return submissions, nil
+}
+
+// Add appends the submission to the history.
+func (h *History) Add(s Submission) error {
+
+	line, err := json.Marshal(s)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(h.Path), 0700); err != nil {
+		return err
+	}
+
+	file, err := os.OpenFile(h.Path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+
+	_, err = file.Write(append(line, '\n'))
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	return err
+}
+
+// Find returns the last submission of the answer with a final verdict.
+func (h *History) Find(year, day, part int, answer string) (Submission, bool, error) {
+
+	submissions, err := h.Load()
+	if err != nil {
+		return Submission{}, false, err
+	}
+
+	for i := len(submissions) - 1; i >= 0; i-- {
+		s := submissions[i]
+		if s.Year == year && s.Day == day && s.Part == part && s.Answer == answer && s.Verdict.IsFinal() {
+			return s, true, nil
+		}
+	}
+
+	return Submission{}, false, nil
+}
@@ -0,0 +1,189 @@
+package inputhandler
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Cache keeps the downloaded puzzle inputs on disk keyed by their URL and
+// the session they were downloaded with, so repeated runs don't need to hit
+// the AoC servers (or the network) again. The inputs are different for every
+// user, so switching to another session doesn't get the previous one's data.
+//
+// Every entry is two files named after the hash of the URL and the session:
+// the data in '<hash>.txt' and the URL itself in '<hash>.url' for listing.
+// The session is never written out, only its hash is in the name.
+type Cache struct {
+	Dir string
+}
+
+// CacheEntry is the description of one cached input.
+type CacheEntry struct {
+	URL     string
+	Path    string
+	Size    int64
+	Fetched time.Time
+}
+
+const (
+	cacheDataExt = ".txt"
+	cacheURLExt  = ".url"
+)
+
+// NewCache creates a Cache in the given directory.
+// The directory is created on the first write.
+func NewCache(dir string) *Cache {
+	return &Cache{Dir: dir}
+}
+
+// DefaultCacheDir returns the directory for the cache in the user's cache directory.
+func DefaultCacheDir() (string, error) {
+	userCacheDir, err := os.UserCacheDir()
+	if err != nil {
+		return "", fmt.Errorf("couldn't determine user cache directory: %w", err)
+	}
+	return filepath.Join(userCacheDir, "aoc25", "inputs"), nil
+}
+
+// Get returns the cached data for the URL and session.
+// The bool is false if it's not cached.
+func (c *Cache) Get(url, session string) (string, bool, error) {
+
+	content, err := os.ReadFile(c.entryPath(url, session, cacheDataExt))
+	if errors.Is(err, fs.ErrNotExist) {
+		return "", false, nil
+	}
+	if err != nil {
+		return "", false, fmt.Errorf("error reading cached input: %w", err)
+	}
+
+	return string(content), true, nil
+}
+
+// Put stores the data for the URL and session, overwriting any previous entry.
+func (c *Cache) Put(url, session, data string) error {
+
+	if err := os.MkdirAll(c.Dir, 0755); err != nil {
+		return fmt.Errorf("error creating cache directory '%s': %w", c.Dir, err)
+	}
+
+	// NOTE: the puzzle inputs are personal so only the user can read them
+	if err := os.WriteFile(c.entryPath(url, session, cacheDataExt), []byte(data), 0600); err != nil {
+		return fmt.Errorf("error writing cached input: %w", err)
+	}
+	if err := os.WriteFile(c.entryPath(url, session, cacheURLExt), []byte(url), 0600); err != nil {
+		return fmt.Errorf("error writing cached input url: %w", err)
+	}
+
+	return nil
+}
+
+// List returns the cached inputs ordered by URL.
+func (c *Cache) List() ([]CacheEntry, error) {
+
+	urlFiles, err := filepath.Glob(filepath.Join(c.Dir, "*"+cacheURLExt))
+	if err != nil {
+		return nil, fmt.Errorf("error listing cache directory '%s': %w", c.Dir, err)
+	}
+
+	entries := make([]CacheEntry, 0, len(urlFiles))
+	for _, urlFile := range urlFiles {
+
+		url, err := os.ReadFile(urlFile)
+		if err != nil {
+			return nil, fmt.Errorf("error reading cached input url: %w", err)
+		}
+
+		dataPath := strings.TrimSuffix(urlFile, cacheURLExt) + cacheDataExt
+		info, err := os.Stat(dataPath)
+		if err != nil {
+			continue // half written entry, Get won't find it either
+		}
+
+		entries = append(entries, CacheEntry{
+			URL:     string(url),
+			Path:    dataPath,
+			Size:    info.Size(),
+			Fetched: info.ModTime(),
+		})
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].URL < entries[j].URL
+	})
+
+	return entries, nil
+}
+
+// Clear removes every cached input.
+func (c *Cache) Clear() error {
+
+	for _, ext := range []string{cacheDataExt, cacheURLExt} {
+		files, err := filepath.Glob(filepath.Join(c.Dir, "*"+ext))
+		if err != nil {
+			return fmt.Errorf("error listing cache directory '%s': %w", c.Dir, err)
+		}
+		for _, file := range files {
+			if err := os.Remove(file); err != nil {
+				return fmt.Errorf("error removing cached input: %w", err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func (c *Cache) entryPath(url, session, ext string) string {
+	hash := sha256.Sum256([]byte(url + "\n" + session))
+	return filepath.Join(c.Dir, hex.EncodeToString(hash[:])+ext)
+}
+
+// GetDataFromWebpageCached is GetDataFromWebpage going through the cache.
+// With 'refresh' it always downloads and overwrites the cached data.
+func GetDataFromWebpageCached(cache *Cache, url string, refresh bool) (string, error) {
+	return DefaultWebClient().GetCached(cache, url, refresh)
+}
+
+// GetCached is Get going through the cache.
+// With 'refresh' it always downloads and overwrites the cached data.
+// The cache failing is only a warning, the data is downloaded anyway.
+func (wc *WebClient) GetCached(cache *Cache, url string, refresh bool) (string, error) {
+
+	// the inputs are per user, so the session is part of the key
+	// NOTE: without one Get warns and the data is cached under no session
+	session, _, err := wc.Credentials.Session()
+	if err != nil && !errors.Is(err, ErrNoSession) {
+		return "", err
+	}
+
+	if !refresh {
+		// a broken cache is no reason not to download
+		data, ok, err := cache.Get(url, session)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: couldn't read the cached input: %v\n", err)
+		}
+		if ok {
+			return data, nil
+		}
+	}
+
+	data, err := wc.Get(url)
+	if err != nil {
+		return "", err
+	}
+
+	// the data is there, only the next run has to download it again
+	if err := cache.Put(url, session, data); err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: couldn't cache the input: %v\n", err)
+	}
+
+	return data, nil
+}
@@ -0,0 +1,145 @@
+package inputhandler
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestGetDataFromWebpageCached(t *testing.T) {
+
+	hits := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits++
+		fmt.Fprintf(w, "%s\nhit %d\n", r.URL.Path, hits)
+	}))
+	defer server.Close()
+
+	t.Setenv(SessionEnvVar, "first-user")
+	cache := NewCache(t.TempDir())
+	url := server.URL + "/2025/day/1/input"
+
+	data, err := GetDataFromWebpageCached(cache, url, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if data != "/2025/day/1/input\nhit 1\n" || hits != 1 {
+		t.Fatalf("first run: got %q with %d hits", data, hits)
+	}
+
+	data, err = GetDataFromWebpageCached(cache, url, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if data != "/2025/day/1/input\nhit 1\n" || hits != 1 {
+		t.Fatalf("cached run: got %q with %d hits", data, hits)
+	}
+
+	data, err = GetDataFromWebpageCached(cache, url, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if data != "/2025/day/1/input\nhit 2\n" || hits != 2 {
+		t.Fatalf("refreshed run: got %q with %d hits", data, hits)
+	}
+
+	// a different URL is a different entry
+	if _, err = GetDataFromWebpageCached(cache, server.URL+"/2025/day/2/input", false); err != nil {
+		t.Fatal(err)
+	}
+	if hits != 3 {
+		t.Fatalf("expected a download for another url, got %d hits", hits)
+	}
+
+	// another user has different inputs, the first one's must not be used
+	t.Setenv(SessionEnvVar, "second-user")
+	data, err = GetDataFromWebpageCached(cache, url, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if data != "/2025/day/1/input\nhit 4\n" || hits != 4 {
+		t.Fatalf("other session: got %q with %d hits", data, hits)
+	}
+
+	// and switching back still finds the first user's one
+	t.Setenv(SessionEnvVar, "first-user")
+	data, err = GetDataFromWebpageCached(cache, url, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if data != "/2025/day/1/input\nhit 2\n" || hits != 4 {
+		t.Fatalf("first session again: got %q with %d hits", data, hits)
+	}
+}
+
+func TestCacheListAndClear(t *testing.T) {
+
+	cache := NewCache(t.TempDir())
+
+	entries, err := cache.List()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 0 {
+		t.Fatalf("expected empty cache, got %v", entries)
+	}
+
+	urls := []string{"https://example.com/b", "https://example.com/a"}
+	for _, url := range urls {
+		if err := cache.Put(url, "session", "data for "+url); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	entries, err = cache.List()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 2 || entries[0].URL != urls[1] || entries[1].URL != urls[0] {
+		t.Fatalf("unexpected entries: %v", entries)
+	}
+	if entries[0].Size != int64(len("data for "+urls[1])) {
+		t.Errorf("unexpected size %d", entries[0].Size)
+	}
+
+	if err := cache.Clear(); err != nil {
+		t.Fatal(err)
+	}
+
+	entries, err = cache.List()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 0 {
+		t.Fatalf("expected empty cache after clear, got %v", entries)
+	}
+	if _, ok, _ := cache.Get(urls[0], "session"); ok {
+		t.Fatal("expected no data after clear")
+	}
+}
+
+func TestGetDataFromWebpageCachedUnwritable(t *testing.T) {
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintln(w, "1,2")
+	}))
+	defer server.Close()
+
+	// the cache directory would be under a file, so it can't be created
+	file := filepath.Join(t.TempDir(), "file")
+	if err := os.WriteFile(file, nil, 0644); err != nil {
+		t.Fatal(err)
+	}
+	cache := NewCache(filepath.Join(file, "cache"))
+
+	data, err := GetDataFromWebpageCached(cache, server.URL, false)
+	if err != nil {
+		t.Fatalf("the downloaded data should be returned, got %v", err)
+	}
+	if data != "1,2\n" {
+		t.Errorf("got %q", data)
+	}
+}
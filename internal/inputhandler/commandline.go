@@ -0,0 +1,271 @@
+package inputhandler
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// ErrorInvalidParameters returnde by ParseCommandLine when it faild to parse parameters.
+var ErrorInvalidParameters = fmt.Errorf("invalid parameters")
+
+// Options is the commandline flag set. The input parameters and the common
+// options are registered here, and solutions can register their own too.
+// Do it before calling ReadInput or ParseCommandLine, like:
+//
+//	var limit = inputhandler.Options.Int("limit", 1000, "how many to do")
+var Options = newOptions()
+
+func newOptions() *flag.FlagSet {
+	fs := flag.NewFlagSet(filepath.Base(os.Args[0]), flag.ContinueOnError)
+	fs.SetOutput(io.Discard) // ReadInput prints the usage
+	return fs
+}
+
+// input parameters - only one of these can be given
+var inputParams = Options.String("p", "", "data is provided as a ';' separated value")
+var inputFile = Options.String("f", "", "data is in the file pointed to by the provided path")
+var inputURL = Options.String("w", "", "data is given by a website pointed to by the provided url")
+var inputStdin = Options.Bool("s", false, "data is read from the standard input until EOF ('-' works too)")
+var cacheCommand = Options.String("c", "", "manage the cached webpage inputs: list or clear")
+
+// common options for the solutions
+var part = Options.Int("part", 0, "run only part 1 or 2 of the solution (default both)")
+var verbose = Options.Bool("verbose", false, "print what the solution is doing")
+var example = Options.Bool("example", false, "the input is the puzzle example - reads the "+ExampleFile+" of the tests if no input is given")
+
+// ExampleFile is the example in the testdata directory of the tests of a day,
+// the input for '--example'.
+const ExampleFile = "example1.txt"
+
+// TestdataDir returns the testdata directory of the tests of the day, the
+// one with the examples. It's cmd/dayNN/testdata from the repo root, and
+// ../dayNN/testdata from the directory of any of the commands - the first
+// one existing, or the first if none. Day 0 is the current directory's.
+func TestdataDir(day int) string {
+
+	if day < 1 {
+		return "testdata"
+	}
+
+	name := fmt.Sprintf("day%02d", day)
+	candidates := []string{
+		filepath.Join("cmd", name, "testdata"),
+		filepath.Join("..", name, "testdata"),
+	}
+	for _, dir := range candidates {
+		if info, err := os.Stat(dir); err == nil && info.IsDir() {
+			return dir
+		}
+	}
+
+	return candidates[0]
+}
+
+// ExamplePath returns the path of the example of the day, see TestdataDir.
+func ExamplePath(day int) string {
+	return filepath.Join(TestdataDir(day), ExampleFile)
+}
+
+var refreshCache = Options.Bool("refresh", false, "download the webpage input again even if it's cached")
+var cacheDir = Options.String("cache-dir", "", "directory of the cached webpage inputs (default is in the user cache directory)")
+
+// Part returns the part selected on the commandline, or 0 for both.
+func Part() int {
+	return *part
+}
+
+// Verbose reports whether the solution should print what it's doing.
+func Verbose() bool {
+	return *verbose
+}
+
+// Example reports whether the input is the puzzle example,
+// so the solutions can use the parameters from the puzzle text.
+func Example() bool {
+	return *example
+}
+
+// ParseCommandLine is the commandline parser.
+// It returns the determined input method, the associated parameter value, or the error if any.
+func ParseCommandLine() (InputMethod, string, error) {
+	config, err := ParseArgs(os.Args[1:])
+	if err != nil {
+		return InputInvalid, "", err
+	}
+	return config.Method, config.Value, nil
+}
+
+// ParseArgs parses the given arguments - without the app name - into Options,
+// and returns the Config for Read. With '--example' and no input given it
+// reads the example in the testdata directory. Returns flag.ErrHelp if help
+// was asked for.
+func ParseArgs(args []string) (Config, error) {
+	return ParseDayArgs(0, args)
+}
+
+// ParseDayArgs is ParseArgs for a day, reading its example with '--example'
+// from wherever ExamplePath finds it.
+func ParseDayArgs(day int, args []string) (Config, error) {
+
+	config, err := ParseOptions(args)
+	if err != nil {
+		return config, err
+	}
+
+	if config.Method == InputInvalid && *example {
+		config.Method = InputFile
+		config.Value = ExamplePath(day)
+	}
+	if config.Method == InputInvalid {
+		return config, fmt.Errorf("%w: exactly one of -p, -f, -w, -s or -c is needed", ErrorInvalidParameters)
+	}
+
+	return config, nil
+}
+
+// ParseOptions is like ParseArgs, but giving the input is optional. This is
+// for apps finding the input themselves, when Config.Method is InputInvalid.
+func ParseOptions(args []string) (Config, error) {
+
+	// the values would stay from a previous parse, the solutions' ones too
+	Options.VisitAll(func(f *flag.Flag) {
+		f.Value.Set(f.DefValue)
+	})
+
+	// a lone '-' would stop the flag parsing, but it can be the value of a flag too
+	args = append([]string{}, args...)
+	for i, arg := range args {
+		if arg == "-" && (i == 0 || !needsValue(args[i-1])) {
+			args[i] = "-s"
+		}
+	}
+
+	if err := Options.Parse(args); err != nil {
+		if err == flag.ErrHelp {
+			return Config{Method: InputInvalid}, err
+		}
+		return Config{Method: InputInvalid}, fmt.Errorf("%w: %w", ErrorInvalidParameters, err)
+	}
+	if Options.NArg() != 0 {
+		return Config{Method: InputInvalid}, fmt.Errorf("%w: unexpected arguments %v", ErrorInvalidParameters, Options.Args())
+	}
+
+	if *part < 0 || *part > 2 {
+		return Config{Method: InputInvalid}, fmt.Errorf("%w: part can only be 1 or 2 (%d)", ErrorInvalidParameters, *part)
+	}
+
+	method, value := InputInvalid, ""
+	inputCount := 0
+	for _, input := range []struct {
+		given  bool
+		method InputMethod
+		value  string
+	}{
+		{*inputParams != "", InputParameters, *inputParams},
+		{*inputFile != "", InputFile, *inputFile},
+		{*inputURL != "", InputWebpage, *inputURL},
+		{*inputStdin, InputStdin, ""},
+		{*cacheCommand != "", InputCache, *cacheCommand},
+	} {
+		if input.given {
+			method = input.method
+			value = input.value
+			inputCount++
+		}
+	}
+
+	if inputCount > 1 {
+		return Config{Method: InputInvalid}, fmt.Errorf("%w: only one of -p, -f, -w, -s or -c can be given", ErrorInvalidParameters)
+	}
+
+	return OptionsConfig(method, value)
+}
+
+// needsValue reports whether the argument is an option taking
+// the next argument as its value, like '-f' but not '-s' or '-f=x'.
+func needsValue(arg string) bool {
+
+	name, ok := strings.CutPrefix(arg, "-")
+	if !ok || strings.Contains(name, "=") {
+		return false
+	}
+	name = strings.TrimPrefix(name, "-")
+
+	f := Options.Lookup(name)
+	if f == nil {
+		return false
+	}
+	if boolFlag, ok := f.Value.(interface{ IsBoolFlag() bool }); ok && boolFlag.IsBoolFlag() {
+		return false
+	}
+
+	return true
+}
+
+// OptionsConfig returns the Config for reading the given input
+// with the cache settings parsed from the commandline. The default
+// cache directory is only looked up for the inputs using the cache,
+// so the others work without a home directory too.
+func OptionsConfig(method InputMethod, value string) (Config, error) {
+
+	config := Config{
+		Method:       method,
+		Value:        value,
+		CacheDir:     *cacheDir,
+		RefreshCache: *refreshCache,
+	}
+
+	if config.CacheDir == "" && (method == InputWebpage || method == InputCache) {
+		dir, err := DefaultCacheDir()
+		if err != nil {
+			return Config{Method: InputInvalid}, err
+		}
+		config.CacheDir = dir
+	}
+
+	return config, nil
+}
+
+// RunCacheCommand does the cache management asked for on the commandline
+// with '-c' - this is when ParseArgs returns InputCache as the method.
+func RunCacheCommand(cache *Cache, command string) error {
+
+	switch command {
+	case "list":
+		entries, err := cache.List()
+		if err != nil {
+			return err
+		}
+		fmt.Printf("Cached inputs in '%s':\n", cache.Dir)
+		for _, entry := range entries {
+			fmt.Printf("%s  %6d bytes  %s\n", entry.Fetched.Format("2006-01-02 15:04"), entry.Size, entry.URL)
+		}
+		return nil
+
+	case "clear":
+		if err := cache.Clear(); err != nil {
+			return err
+		}
+		fmt.Printf("Cleared cached inputs in '%s'\n", cache.Dir)
+		return nil
+	}
+
+	return fmt.Errorf("unknown cache command (%s)", command)
+}
+
+// printUsage prints the help generated from the registered Options.
+func printUsage() {
+	fmt.Printf("Usage: %s [-p data | -f path | -w url | -s | -c list/clear] [options]\n\n", Options.Name())
+	PrintOptions()
+}
+
+// PrintOptions prints the description of all the registered Options.
+func PrintOptions() {
+	Options.SetOutput(os.Stdout)
+	Options.PrintDefaults()
+	Options.SetOutput(io.Discard)
+}
@@ -0,0 +1,8 @@
+//go:build !windows && !plan9
+
+package inputhandler
+
+import "syscall"
+
+// connectionErrors are the dropped connections worth trying again.
+var connectionErrors = []error{syscall.ECONNREFUSED, syscall.ECONNRESET}
@@ -0,0 +1,5 @@
+package inputhandler
+
+// connectionErrors are the dropped connections worth trying again.
+// NOTE: the errors are just strings here, so only timeouts are retried.
+var connectionErrors = []error{}
@@ -0,0 +1,7 @@
+package inputhandler
+
+import "golang.org/x/sys/windows"
+
+// connectionErrors are the dropped connections worth trying again.
+// NOTE: the syscall ones are made up on Windows, these are what WinSock gives.
+var connectionErrors = []error{windows.WSAECONNREFUSED, windows.WSAECONNRESET}
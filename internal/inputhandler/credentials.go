@@ -0,0 +1,80 @@
+package inputhandler
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// SessionEnvVar is the environment variable holding the AoC session cookie value.
+const SessionEnvVar = "AOC_SESSION"
+
+// ErrNoSession is returned by Credentials.Session when none of the sources has a session.
+var ErrNoSession = errors.New("no session found")
+
+// Credentials finds the session cookie value the Advent of Code site
+// needs to identify the user. The sources are checked in this order:
+// the environment variable, the session file in the config directory,
+// the explicitly given session file, and for compatibility the
+// session.txt in the current directory. The first one found wins.
+//
+// NOTE: The session is a secret. It is never printed, only where it came from.
+type Credentials struct {
+	EnvVar       string
+	ConfigFile   string
+	ExplicitFile string
+}
+
+// DefaultCredentials returns the Credentials set up with the
+// default sources and the file from the -session-file option.
+func DefaultCredentials() *Credentials {
+
+	creds := &Credentials{
+		EnvVar:       SessionEnvVar,
+		ExplicitFile: *sessionFile,
+	}
+
+	if configDir, err := os.UserConfigDir(); err == nil {
+		creds.ConfigFile = filepath.Join(configDir, "aoc25", "session.txt")
+	}
+
+	return creds
+}
+
+var sessionFile = Options.String("session-file", "", "file containing the AoC session cookie value (env "+SessionEnvVar+" and the config dir are checked first)")
+
+const legacySessionFile = "session.txt"
+
+// Session returns the session value with whitespace trimmed, and a
+// description of where it came from. Returns ErrNoSession if not found.
+func (c *Credentials) Session() (string, string, error) {
+
+	if c.EnvVar != "" {
+		if session := strings.TrimSpace(os.Getenv(c.EnvVar)); session != "" {
+			return session, "environment variable " + c.EnvVar, nil
+		}
+	}
+
+	for _, file := range []string{c.ConfigFile, c.ExplicitFile, legacySessionFile} {
+		if file == "" {
+			continue
+		}
+
+		content, err := os.ReadFile(file)
+		if errors.Is(err, fs.ErrNotExist) && file != c.ExplicitFile {
+			continue
+		}
+		if err != nil {
+			return "", "", fmt.Errorf("error reading session file '%s': %w", file, err)
+		}
+
+		if session := strings.TrimSpace(string(content)); session != "" {
+			return session, "file " + file, nil
+		}
+	}
+
+	return "", "", fmt.Errorf("%w - set %s or put it into '%s'", ErrNoSession, c.EnvVar, c.ConfigFile)
+}
@@ -0,0 +1,85 @@
+package inputhandler
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestCredentialsOrder(t *testing.T) {
+
+	dir := t.TempDir()
+	t.Chdir(dir) // so no session.txt is found by accident
+
+	configFile := filepath.Join(dir, "config_session.txt")
+	explicitFile := filepath.Join(dir, "explicit_session.txt")
+	creds := &Credentials{EnvVar: "AOC25_TEST_SESSION", ConfigFile: configFile, ExplicitFile: explicitFile}
+
+	check := func(expected, expectedSource string) {
+		t.Helper()
+		session, source, err := creds.Session()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if session != expected || !strings.Contains(source, expectedSource) {
+			t.Fatalf("got session %q from %q, expected %q from %q", session, source, expected, expectedSource)
+		}
+	}
+
+	// explicit file given but missing is an error
+	if _, _, err := creds.Session(); err == nil || errors.Is(err, ErrNoSession) {
+		t.Fatalf("expected read error for missing explicit file, got %v", err)
+	}
+
+	os.WriteFile(explicitFile, []byte("explicit\n"), 0600)
+	check("explicit", explicitFile)
+
+	os.WriteFile(configFile, []byte("  config \r\n"), 0600)
+	check("config", configFile)
+
+	t.Setenv(creds.EnvVar, "env\n")
+	check("env", creds.EnvVar)
+}
+
+func TestCredentialsNoSession(t *testing.T) {
+
+	dir := t.TempDir()
+	t.Chdir(dir)
+
+	creds := &Credentials{EnvVar: "AOC25_TEST_SESSION", ConfigFile: filepath.Join(dir, "missing.txt")}
+	if _, _, err := creds.Session(); !errors.Is(err, ErrNoSession) {
+		t.Fatalf("expected ErrNoSession, got %v", err)
+	}
+
+	// whitespace only doesn't count
+	os.WriteFile(legacySessionFile, []byte(" \n"), 0600)
+	if _, _, err := creds.Session(); !errors.Is(err, ErrNoSession) {
+		t.Fatalf("expected ErrNoSession for empty file, got %v", err)
+	}
+}
+
+func TestGetDataFromWebpageLoginPage(t *testing.T) {
+
+	t.Chdir(t.TempDir())
+	t.Setenv(SessionEnvVar, "expired-session")
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if cookie, err := r.Cookie("session"); err != nil || cookie.Value != "expired-session" {
+			t.Errorf("session cookie not sent properly: %v", cookie)
+		}
+		w.Write([]byte("Puzzle inputs differ by user.  Please log in to get your puzzle input.\n"))
+	}))
+	defer server.Close()
+
+	_, err := GetDataFromWebpage(server.URL)
+	if !errors.Is(err, ErrNotLoggedIn) {
+		t.Fatalf("expected ErrNotLoggedIn, got %v", err)
+	}
+	if strings.Contains(err.Error(), "expired-session") {
+		t.Fatalf("error contains the secret: %v", err)
+	}
+}
@@ -1,59 +1,181 @@
 // Adds alternative input providing functionality to Advent of Code puzzle solutions.
-// Supports input directly from the commandline, a separate file, and from a webpage.
+// Supports input directly from the commandline, a separate file, the standard input, and from a webpage.
 //
 // Suggested usage: lines := ReadInput()
+//
+// To use it as a library without exiting the app on errors,
+// call Read with a Config, or ReadFrom with a reader.
+//
+// Solutions can have their own options by registering them into Options
+// before calling ReadInput. The commandline is parsed with the flag package,
+// so the options can be in any order and '--help' lists all of them.
 package inputhandler
 
 import (
+	"errors"
+	"flag"
 	"fmt"
 	"io"
-	"net/http"
 	"os"
 	"strings"
 )
 
 // ReadInput is a one call method to parse the commandline and return the input data as separate lines.
 // On any caught error, it will exit the app with an error text.
+// It is a thin wrapper around ParseArgs and Read.
 func ReadInput() []string {
+	return ReadDayInput(0)
+}
 
-	inputMethod, paramValue, err := ParseCommandLine()
+// ReadDayInput is ReadInput for a day, so '--example' finds its example.
+func ReadDayInput(day int) []string {
+
+	config, err := ParseDayArgs(day, os.Args[1:])
+	if errors.Is(err, flag.ErrHelp) {
+		printUsage()
+		os.Exit(0)
+	}
 	if err != nil {
 		fmt.Printf("Error while parsing command line: %v\n\n", err)
-		fmt.Println("Usage: cmd -[p/f/w] [data/uri]")
-		fmt.Println("p - data is provided as a ';' separated value")
-		fmt.Println("f - data is in the file pointed to by the provided path")
-		fmt.Println("w - data is given by a website pointed to by the provided url")
+		printUsage()
 		os.Exit(int(ErrorCodeParameters))
 	}
 
+	if config.Method == InputCache {
+		if err := RunCacheCommand(NewCache(config.CacheDir), config.Value); err != nil {
+			fmt.Fprintln(os.Stderr, "Error while managing input cache:", err)
+			os.Exit(int(ErrorCodeFiles))
+		}
+		os.Exit(0)
+	}
+
+	lines, err := Read(config)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		os.Exit(int(ErrorCodeOf(err)))
+	}
+
+	return lines
+}
+
+// Config describes where Read gets the input from.
+type Config struct {
+	Method InputMethod
+	// Value is the data, the path or the URL depending on the Method.
+	Value string
+	// Stdin is read for InputStdin. It's os.Stdin if not set.
+	Stdin io.Reader
+	// Web downloads for InputWebpage. It's DefaultWebClient() if not set.
+	Web *WebClient
+	// CacheDir is where the webpage inputs are cached. No caching if empty.
+	CacheDir string
+	// RefreshCache downloads the webpage input even if it's cached.
+	RefreshCache bool
+}
+
+// Error is returned by Read and carries the matching exit code.
+type Error struct {
+	Code ErrorCodes
+	Err  error
+}
+
+func (e *Error) Error() string {
+	return e.Err.Error()
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// ErrorCode returns the exit code this error should end the app with.
+func (e *Error) ErrorCode() ErrorCodes {
+	return e.Code
+}
+
+// ErrNoData is returned by Read when the input has no lines.
+var ErrNoData = fmt.Errorf("no data was given")
+
+// ErrorCodeOf returns the exit code matching the error, which is
+// ErrorCodeProcessing for errors not coming from this package.
+func ErrorCodeOf(err error) ErrorCodes {
+	var coded interface{ ErrorCode() ErrorCodes }
+	if errors.As(err, &coded) {
+		return coded.ErrorCode()
+	}
+	return ErrorCodeProcessing
+}
+
+// Read returns the input data as separate lines from the source given
+// in the config. It never exits, the returned errors are *Error values.
+func Read(config Config) ([]string, error) {
+
 	var lines []string
-	switch inputMethod {
+	switch config.Method {
 	case InputParameters:
-		lines = strings.Split(paramValue, ";")
+		lines = strings.Split(config.Value, ";")
 
 	case InputFile:
-		inputData, err := GetDataFromFile(paramValue)
+		inputData, err := GetDataFromFile(config.Value)
 		if err != nil {
-			fmt.Printf("Error while reading from file '%s': %v", paramValue, err)
-			os.Exit(int(ErrorCodeFiles))
+			return nil, &Error{ErrorCodeFiles, fmt.Errorf("error while reading from file '%s': %w", config.Value, err)}
 		}
-		lines = strings.Split(strings.TrimSuffix(inputData, "\n"), "\n")
+		lines = splitLines(inputData)
+
+	case InputStdin:
+		stdin := config.Stdin
+		if stdin == nil {
+			stdin = os.Stdin
+		}
+		return ReadFrom(stdin)
 
 	case InputWebpage:
-		inputData, err := GetDataFromWebpage(paramValue)
+		web := config.Web
+		if web == nil {
+			web = DefaultWebClient()
+		}
+
+		var inputData string
+		var err error
+		if config.CacheDir != "" {
+			inputData, err = web.GetCached(NewCache(config.CacheDir), config.Value, config.RefreshCache)
+		} else {
+			inputData, err = web.Get(config.Value)
+		}
 		if err != nil {
-			fmt.Printf("Error while reading from URL '%s': %v", paramValue, err)
-			os.Exit(int(ErrorCodeNetwork))
+			return nil, &Error{ErrorCodeNetwork, fmt.Errorf("error while reading from URL '%s': %w", config.Value, err)}
 		}
-		lines = strings.Split(strings.TrimSuffix(inputData, "\n"), "\n")
+		lines = splitLines(inputData)
 
+	default:
+		return nil, &Error{ErrorCodeParameters, fmt.Errorf("%w: not an input method (%s)", ErrorInvalidParameters, config.Method)}
 	}
+
 	if len(lines) == 0 {
-		fmt.Println("Error: no data was given")
-		os.Exit(4)
+		return nil, &Error{ErrorCodeData, ErrNoData}
 	}
 
-	return lines
+	return lines, nil
+}
+
+// ReadFrom reads the reader until EOF and returns the data as separate lines.
+func ReadFrom(r io.Reader) ([]string, error) {
+
+	inputData, err := GetDataFromReader(r)
+	if err != nil {
+		return nil, &Error{ErrorCodeFiles, fmt.Errorf("error while reading input: %w", err)}
+	}
+
+	lines := splitLines(inputData)
+	if len(lines) == 0 {
+		return nil, &Error{ErrorCodeData, ErrNoData}
+	}
+
+	return lines, nil
+}
+
+// splitLines splits the data into lines, ignoring the closing line break.
+func splitLines(data string) []string {
+	return strings.Split(strings.TrimSuffix(data, "\n"), "\n")
 }
 
 // ErrorCodes is the suggested application exit codes.
@@ -75,34 +197,11 @@ const (
 	InputInvalid    InputMethod = "InputInvalid"
 	InputParameters InputMethod = "InputParameters"
 	InputFile       InputMethod = "InputFile"
+	InputStdin      InputMethod = "InputStdin"
 	InputWebpage    InputMethod = "InputWebpage"
+	InputCache      InputMethod = "InputCache"
 )
 
-// ErrorInvalidParameters returnde by ParseCommandLine when it faild to parse parameters.
-var ErrorInvalidParameters = fmt.Errorf("invalid parameters")
-
-// ParseCommandLine is the commandline parser.
-// It returns the determined input method, the associated parameter value, or the error if any.
-func ParseCommandLine() (InputMethod, string, error) {
-
-	args := os.Args[1:]
-
-	if len(args) < 2 {
-		return InputInvalid, "", ErrorInvalidParameters
-	}
-
-	switch args[0] {
-	case "-p":
-		return InputParameters, args[1], nil
-	case "-f":
-		return InputFile, args[1], nil
-	case "-w":
-		return InputWebpage, args[1], nil
-	}
-
-	return InputInvalid, "", ErrorInvalidParameters
-}
-
 // GetDataFromFile will try to open the file at the given path and returns it's contents or an error if any.
 func GetDataFromFile(path string) (string, error) {
 
@@ -114,32 +213,13 @@ func GetDataFromFile(path string) (string, error) {
 	return string(content), nil
 }
 
-// GetDataFromWebpage will try to make a GET request to the given URL and returns the downloaded data as text, or an error if any.
-// Optionally it reads the contents of the session.txt file if exists and adds it as a "session" cookie to the request.
-// (Advent of Code site needs this to identify the current user.)
-func GetDataFromWebpage(url string) (string, error) {
+// GetDataFromReader reads everything from the reader until EOF and returns it, or an error if any.
+func GetDataFromReader(r io.Reader) (string, error) {
 
-	client := &http.Client{}
-	req, err := http.NewRequest("GET", url, nil)
+	content, err := io.ReadAll(r)
 	if err != nil {
 		return "", err
 	}
 
-	sessionValue, err := os.ReadFile("session.txt")
-	if err == nil {
-		req.AddCookie(&http.Cookie{Name: "session", Value: string(sessionValue)})
-	}
-
-	resp, err := client.Do(req)
-	if err != nil {
-		return "", err
-	}
-	defer resp.Body.Close()
-
-	data, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return "", err
-	}
-
-	return string(data), nil
+	return string(content), nil
 }
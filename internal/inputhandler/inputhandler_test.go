@@ -0,0 +1,211 @@
+package inputhandler
+
+import (
+	"errors"
+	"flag"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestRead(t *testing.T) {
+
+	dir := t.TempDir()
+	inputPath := filepath.Join(dir, "input.txt")
+	os.WriteFile(inputPath, []byte("L68\nR48\n"), 0600)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("L68\nR48\n"))
+	}))
+	defer server.Close()
+	web := testWebClient()
+
+	configs := map[string]Config{
+		"parameters": {Method: InputParameters, Value: "L68;R48"},
+		"file":       {Method: InputFile, Value: inputPath},
+		"stdin":      {Method: InputStdin, Stdin: strings.NewReader("L68\nR48\n")},
+		"webpage":    {Method: InputWebpage, Value: server.URL, Web: web},
+		"cached":     {Method: InputWebpage, Value: server.URL, Web: web, CacheDir: filepath.Join(dir, "cache")},
+	}
+
+	for name, config := range configs {
+		lines, err := Read(config)
+		if err != nil {
+			t.Errorf("%s: %v", name, err)
+			continue
+		}
+		if !slices.Equal(lines, []string{"L68", "R48"}) {
+			t.Errorf("%s: got %q", name, lines)
+		}
+	}
+}
+
+func TestReadErrors(t *testing.T) {
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "Not Found", http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	cases := map[string]struct {
+		config   Config
+		expected ErrorCodes
+	}{
+		"missing file": {Config{Method: InputFile, Value: filepath.Join(t.TempDir(), "missing.txt")}, ErrorCodeFiles},
+		"bad status":   {Config{Method: InputWebpage, Value: server.URL, Web: testWebClient()}, ErrorCodeNetwork},
+		"no method":    {Config{Method: InputInvalid}, ErrorCodeParameters},
+	}
+
+	for name, c := range cases {
+		_, err := Read(c.config)
+
+		var inputErr *Error
+		if !errors.As(err, &inputErr) {
+			t.Errorf("%s: expected *Error, got %v", name, err)
+			continue
+		}
+		if ErrorCodeOf(err) != c.expected {
+			t.Errorf("%s: got error code %d, expected %d", name, ErrorCodeOf(err), c.expected)
+		}
+	}
+
+	if code := ErrorCodeOf(errors.New("something else")); code != ErrorCodeProcessing {
+		t.Errorf("unknown error: got error code %d", code)
+	}
+}
+
+func TestParseArgs(t *testing.T) {
+
+	cases := map[string]struct {
+		args   []string
+		method InputMethod
+		value  string
+	}{
+		"file":          {[]string{"-f", "input.txt"}, InputFile, "input.txt"},
+		"stdin":         {[]string{"-s"}, InputStdin, ""},
+		"dash":          {[]string{"-", "-refresh"}, InputStdin, ""},
+		"dash after":    {[]string{"--verbose", "-"}, InputStdin, ""},
+		"dash file":     {[]string{"-f", "-"}, InputFile, "-"},
+		"dash data":     {[]string{"--part", "1", "-p=x", "-cache-dir", "-"}, InputParameters, "x"},
+		"web":           {[]string{"-w", "https://adventofcode.com/2025/day/1/input", "-retries", "1"}, InputWebpage, "https://adventofcode.com/2025/day/1/input"},
+		"cache command": {[]string{"-c", "list"}, InputCache, "list"},
+		"any order":     {[]string{"--verbose", "--part", "2", "-f", "input.txt"}, InputFile, "input.txt"},
+		"example":       {[]string{"--example"}, InputFile, filepath.Join("testdata", ExampleFile)},
+	}
+
+	for name, c := range cases {
+		config, err := ParseArgs(c.args)
+		if err != nil {
+			t.Errorf("%s: %v", name, err)
+			continue
+		}
+		if config.Method != c.method || config.Value != c.value {
+			t.Errorf("%s: got %s (%s)", name, config.Method, config.Value)
+		}
+	}
+
+	for _, args := range [][]string{{}, {"-f"}, {"-x", "value"}, {"-f", "input.txt", "-no-such-option"},
+		{"-f", "input.txt", "-s"}, {"-f", "input.txt", "extra"}, {"-f", "input.txt", "--part", "3"}} {
+		if _, err := ParseArgs(args); !errors.Is(err, ErrorInvalidParameters) {
+			t.Errorf("%v: expected ErrorInvalidParameters, got %v", args, err)
+		}
+	}
+}
+
+func TestExamplePath(t *testing.T) {
+
+	root, err := filepath.Abs(filepath.Join("..", ".."))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// from the repo root and from the directory of any command
+	cases := map[string]string{
+		root:                                filepath.Join("cmd", "day08", "testdata", ExampleFile),
+		filepath.Join(root, "cmd", "day08"): filepath.Join("..", "day08", "testdata", ExampleFile),
+		filepath.Join(root, "cmd", "aoc"):   filepath.Join("..", "day08", "testdata", ExampleFile),
+	}
+	for dir, expected := range cases {
+		t.Chdir(dir)
+
+		config, err := ParseDayArgs(8, []string{"--example"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if config.Method != InputFile || config.Value != expected {
+			t.Errorf("%s: expected %s, got %s (%s)", dir, expected, config.Method, config.Value)
+		}
+		if _, err := Read(config); err != nil {
+			t.Errorf("%s: %v", dir, err)
+		}
+	}
+}
+
+func TestParseArgsWithoutHome(t *testing.T) {
+
+	// like in a bare CI container - there's no user cache directory
+	for _, env := range []string{"HOME", "XDG_CACHE_HOME", "LocalAppData", "home"} {
+		t.Setenv(env, "")
+	}
+
+	config, err := ParseArgs([]string{"-p", "L68;R48"})
+	if err != nil {
+		t.Fatalf("the cache is not needed for -p, got %v", err)
+	}
+	if config.Method != InputParameters || config.CacheDir != "" {
+		t.Errorf("got %s (%s) with cache dir '%s'", config.Method, config.Value, config.CacheDir)
+	}
+
+	// the webpage inputs are cached, so the directory is needed...
+	if _, err := ParseArgs([]string{"-w", "https://adventofcode.com/2025/day/1/input"}); err == nil {
+		t.Error("expected an error for the missing cache directory")
+	}
+
+	// ...unless it's given
+	config, err = ParseArgs([]string{"-w", "https://adventofcode.com/2025/day/1/input", "-cache-dir", "cache"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if config.CacheDir != "cache" {
+		t.Errorf("got cache dir '%s'", config.CacheDir)
+	}
+}
+
+// testLimit is like the options the solutions register.
+var testLimit = Options.Int("test-limit", 1000, "limit for the test")
+
+func TestParseArgsOptions(t *testing.T) {
+
+	if _, err := ParseArgs([]string{"-s", "--part", "1", "--verbose", "--example", "-refresh", "-cache-dir", "cache",
+		"-session-file", "session.txt", "-timeout", "1s", "-retries", "0", "-test-limit", "10"}); err != nil {
+		t.Fatal(err)
+	}
+	if Part() != 1 || !Verbose() || !Example() {
+		t.Errorf("got part %d, verbose %v, example %v", Part(), Verbose(), Example())
+	}
+	if !*refreshCache || *cacheDir != "cache" || *sessionFile != "session.txt" || *webTimeout != time.Second || *webRetries != 0 || *testLimit != 10 {
+		t.Errorf("got refresh %v, cache dir '%s', session file '%s', timeout %v, retries %d, limit %d",
+			*refreshCache, *cacheDir, *sessionFile, *webTimeout, *webRetries, *testLimit)
+	}
+
+	// nothing stays from the previous parse
+	if _, err := ParseArgs([]string{"-s"}); err != nil {
+		t.Fatal(err)
+	}
+	if Part() != 0 || Verbose() || Example() {
+		t.Errorf("got part %d, verbose %v, example %v", Part(), Verbose(), Example())
+	}
+	if *refreshCache || *cacheDir != "" || *sessionFile != "" || *webTimeout != 30*time.Second || *webRetries != 3 || *testLimit != 1000 {
+		t.Errorf("got refresh %v, cache dir '%s', session file '%s', timeout %v, retries %d, limit %d",
+			*refreshCache, *cacheDir, *sessionFile, *webTimeout, *webRetries, *testLimit)
+	}
+
+	if _, err := ParseArgs([]string{"--help"}); !errors.Is(err, flag.ErrHelp) {
+		t.Errorf("expected flag.ErrHelp, got %v", err)
+	}
+}
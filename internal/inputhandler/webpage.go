@@ -0,0 +1,244 @@
+package inputhandler
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+)
+
+// UserAgent identifies the tool to the AoC servers as they ask for it.
+const UserAgent = "github.com/rawbits2010/AoC25 inputhandler"
+
+// BaseURL is the address of the Advent of Code site.
+const BaseURL = "https://adventofcode.com"
+
+// Year of the puzzles solved here.
+const Year = 2025
+
+// InputURL returns the address of the puzzle input of the day.
+func InputURL(day int) string {
+	return fmt.Sprintf("%s/%d/day/%d/input", BaseURL, Year, day)
+}
+
+// ErrNotLoggedIn is returned when the server answers with the "please log in" page.
+var ErrNotLoggedIn = errors.New("the server asked to log in - the session is missing or expired")
+
+// ErrNotUnlocked is returned when the server says the puzzle is not available yet.
+var ErrNotUnlocked = errors.New("the puzzle is not unlocked yet")
+
+// HTTPError is returned when the server answers with an error status,
+// or with one of the known AoC error pages.
+type HTTPError struct {
+	URL        string
+	StatusCode int
+	// Err is ErrNotLoggedIn or ErrNotUnlocked if the page was recognized
+	Err error
+	// Body is the beginning of the answer for the unrecognized errors
+	Body string
+}
+
+func (e *HTTPError) Error() string {
+	if e.Err != nil {
+		return fmt.Sprintf("%s (HTTP %d)", e.Err, e.StatusCode)
+	}
+	if e.Body != "" {
+		return fmt.Sprintf("HTTP %d %s: %s", e.StatusCode, http.StatusText(e.StatusCode), e.Body)
+	}
+	return fmt.Sprintf("HTTP %d %s", e.StatusCode, http.StatusText(e.StatusCode))
+}
+
+func (e *HTTPError) Unwrap() error {
+	return e.Err
+}
+
+// ErrorCode returns the exit code this error should end the app with.
+func (e *HTTPError) ErrorCode() ErrorCodes {
+	return ErrorCodeNetwork
+}
+
+// isTransient tells if it's worth trying again later.
+func (e *HTTPError) isTransient() bool {
+	return e.StatusCode == http.StatusTooManyRequests || e.StatusCode >= 500
+}
+
+// knownErrorPages are the bodies AoC sends instead of the data.
+var knownErrorPages = []struct {
+	text string
+	err  error
+}{
+	{"Please log in", ErrNotLoggedIn},
+	{"Please don't repeatedly request this endpoint before it unlocks", ErrNotUnlocked},
+}
+
+// WebClient downloads the data from a webpage.
+type WebClient struct {
+	// Timeout is for one request, including reading the body
+	Timeout time.Duration
+	// Retries is how many times to try again on transient errors
+	Retries int
+	// Backoff is the wait before the first retry, doubled for every next one
+	Backoff     time.Duration
+	UserAgent   string
+	Credentials *Credentials
+}
+
+var webTimeout = Options.Duration("timeout", 30*time.Second, "timeout for one webpage request")
+var webRetries = Options.Int("retries", 3, "number of retries on transient webpage errors")
+
+// DefaultWebClient returns a WebClient set up from the options.
+func DefaultWebClient() *WebClient {
+	return &WebClient{
+		Timeout:     *webTimeout,
+		Retries:     *webRetries,
+		Backoff:     time.Second,
+		UserAgent:   UserAgent,
+		Credentials: DefaultCredentials(),
+	}
+}
+
+// GetDataFromWebpage will try to make a GET request to the given URL and returns the downloaded data as text, or an error if any.
+// If a session is found by DefaultCredentials it adds it as a "session" cookie to the request.
+// (Advent of Code site needs this to identify the current user.)
+func GetDataFromWebpage(url string) (string, error) {
+	return DefaultWebClient().Get(url)
+}
+
+// Get downloads the page, retrying with backoff on network errors and
+// on the 429 and 5xx statuses. Other errors are returned right away.
+func (wc *WebClient) Get(url string) (string, error) {
+
+	session, source, err := wc.Credentials.Session()
+	switch {
+	case err == nil:
+	case errors.Is(err, ErrNoSession):
+		fmt.Fprintf(os.Stderr, "Warning: requesting without a session: %v\n", err)
+	default:
+		return "", err
+	}
+
+	wait := wc.Backoff
+	for attempt := 0; ; attempt++ {
+
+		data, err := wc.get(url, session)
+		if err == nil {
+			return data, nil
+		}
+
+		if errors.Is(err, ErrNotLoggedIn) && source != "" {
+			err = fmt.Errorf("%w (session from %s)", err, source)
+		}
+
+		if attempt == wc.Retries || !isTransient(err) {
+			return "", err
+		}
+
+		fmt.Fprintf(os.Stderr, "Warning: request failed, retrying in %v: %v\n", wait, err)
+		time.Sleep(wait)
+		wait *= 2
+	}
+}
+
+// PostForm sends the form to the URL and returns the answer page.
+// It needs a session, and it is never retried - the site may
+// count every request, like the answers submitted.
+func (wc *WebClient) PostForm(url string, form url.Values) (string, error) {
+
+	session, source, err := wc.Credentials.Session()
+	if err != nil {
+		return "", err
+	}
+
+	req, err := http.NewRequest("POST", url, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	data, err := wc.do(req, session)
+	if errors.Is(err, ErrNotLoggedIn) {
+		err = fmt.Errorf("%w (session from %s)", err, source)
+	}
+	return data, err
+}
+
+func (wc *WebClient) get(url, session string) (string, error) {
+
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return "", err
+	}
+
+	return wc.do(req, session)
+}
+
+// do sends the request with the session, and checks the answer for errors.
+func (wc *WebClient) do(req *http.Request, session string) (string, error) {
+
+	client := &http.Client{Timeout: wc.Timeout}
+
+	req.Header.Set("User-Agent", wc.UserAgent)
+	if session != "" {
+		req.AddCookie(&http.Cookie{Name: "session", Value: session})
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", err
+	}
+	body := string(data)
+
+	url := req.URL.String()
+	for _, page := range knownErrorPages {
+		if strings.Contains(body, page.text) {
+			return "", &HTTPError{URL: url, StatusCode: resp.StatusCode, Err: page.err}
+		}
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return "", &HTTPError{URL: url, StatusCode: resp.StatusCode, Body: snippet(body)}
+	}
+
+	return body, nil
+}
+
+func isTransient(err error) bool {
+
+	var httpErr *HTTPError
+	if errors.As(err, &httpErr) {
+		return httpErr.isTransient()
+	}
+
+	// timeouts, refused and reset connections and cut off answers - the rest,
+	// like a bad URL or a failed TLS handshake, would only fail again
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return true
+	}
+	for _, connErr := range connectionErrors {
+		if errors.Is(err, connErr) {
+			return true
+		}
+	}
+	return errors.Is(err, io.ErrUnexpectedEOF)
+}
+
+// snippet returns the first line of the body, cut to a sane length.
+func snippet(body string) string {
+	line, _, _ := strings.Cut(strings.TrimSpace(body), "\n")
+	if len(line) > 80 {
+		line = line[:80] + "..."
+	}
+	return line
+}
@@ -0,0 +1,166 @@
+package inputhandler
+
+import (
+	"errors"
+	"io"
+	"log"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func testWebClient() *WebClient {
+	return &WebClient{
+		Timeout:     time.Second,
+		Retries:     2,
+		Backoff:     time.Millisecond,
+		UserAgent:   UserAgent,
+		Credentials: &Credentials{},
+	}
+}
+
+func TestWebClientErrorStatus(t *testing.T) {
+
+	hits := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits++
+		http.Error(w, "404 Not Found", http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	_, err := testWebClient().Get(server.URL)
+
+	var httpErr *HTTPError
+	if !errors.As(err, &httpErr) || httpErr.StatusCode != http.StatusNotFound {
+		t.Fatalf("expected HTTPError with 404, got %v", err)
+	}
+	if httpErr.ErrorCode() != ErrorCodeNetwork {
+		t.Errorf("unexpected error code %d", httpErr.ErrorCode())
+	}
+	if hits != 1 {
+		t.Errorf("not found shouldn't be retried, got %d hits", hits)
+	}
+}
+
+func TestWebClientKnownErrorPage(t *testing.T) {
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte("Please don't repeatedly request this endpoint before it unlocks! The calendar countdown is synchronized with the server time; the link will be enabled on the calendar the instant this puzzle becomes available.\n"))
+	}))
+	defer server.Close()
+
+	_, err := testWebClient().Get(server.URL)
+	if !errors.Is(err, ErrNotUnlocked) {
+		t.Fatalf("expected ErrNotUnlocked, got %v", err)
+	}
+}
+
+func TestWebClientRetries(t *testing.T) {
+
+	hits := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits++
+		if r.Header.Get("User-Agent") != UserAgent {
+			t.Errorf("unexpected User-Agent (%s)", r.Header.Get("User-Agent"))
+		}
+		if hits < 3 {
+			http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+			return
+		}
+		w.Write([]byte("1,2\n3,4\n"))
+	}))
+	defer server.Close()
+
+	data, err := testWebClient().Get(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if data != "1,2\n3,4\n" || hits != 3 {
+		t.Fatalf("got %q after %d hits", data, hits)
+	}
+
+	// out of retries
+	hits = -10
+	_, err = testWebClient().Get(server.URL)
+	var httpErr *HTTPError
+	if !errors.As(err, &httpErr) || httpErr.StatusCode != http.StatusInternalServerError {
+		t.Fatalf("expected HTTPError with 500, got %v", err)
+	}
+	if hits != -7 {
+		t.Errorf("expected 3 attempts, got %d", hits+10)
+	}
+}
+
+func TestWebClientTimeout(t *testing.T) {
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(200 * time.Millisecond)
+		w.Write([]byte("too late"))
+	}))
+	defer server.Close()
+
+	wc := testWebClient()
+	wc.Timeout = 20 * time.Millisecond
+	wc.Retries = 1
+
+	start := time.Now()
+	if _, err := wc.Get(server.URL); err == nil {
+		t.Fatal("expected timeout error")
+	}
+	if elapsed := time.Since(start); elapsed > 150*time.Millisecond {
+		t.Errorf("timeout not applied, took %v", elapsed)
+	}
+}
+
+func TestWebClientTransportErrors(t *testing.T) {
+
+	// the certificate is not trusted, so the handshake always fails
+	var conns atomic.Int32
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Error("the request shouldn't get through")
+	}))
+	server.Config.ErrorLog = log.New(io.Discard, "", 0)
+	server.Config.ConnState = func(conn net.Conn, state http.ConnState) {
+		if state == http.StateNew {
+			conns.Add(1)
+		}
+	}
+	server.StartTLS()
+	defer server.Close()
+
+	if _, err := testWebClient().Get(server.URL); err == nil || isTransient(err) {
+		t.Fatalf("expected a non-transient TLS error, got %v", err)
+	}
+	if conns.Load() != 1 {
+		t.Errorf("TLS error shouldn't be retried, got %d attempts", conns.Load())
+	}
+
+	// nothing listens on a closed port
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	closedURL := "http://" + listener.Addr().String()
+	listener.Close()
+
+	for _, test := range []struct {
+		url       string
+		transient bool
+	}{
+		{closedURL, true},
+		{"htp://x", false},
+		{"http://[::1", false},
+	} {
+		_, err := testWebClient().get(test.url, "")
+		if err == nil {
+			t.Fatalf("%s: expected an error", test.url)
+		}
+		if isTransient(err) != test.transient {
+			t.Errorf("%s: transient should be %v for %v", test.url, test.transient, err)
+		}
+	}
+}
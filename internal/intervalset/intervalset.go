@@ -0,0 +1,123 @@
+// intervalset package provides a set of integers stored as sorted,
+// merged closed intervals. Works on arbitrarily large numbers.
+package intervalset
+
+import (
+	"sort"
+
+	"github.com/rawbits2010/AoC25/internal/bignum"
+)
+
+// Interval is the closed range of integers [Start, End].
+type Interval struct {
+	Start bignum.Int
+	End   bignum.Int
+}
+
+// Count returns the number of integers in the interval.
+func (iv Interval) Count() bignum.Int {
+	if iv.End.Cmp(iv.Start) < 0 {
+		return bignum.Int{}
+	}
+	return iv.End.Sub(iv.Start).Inc()
+}
+
+// Set is a set of integers kept as a sorted list of disjoint intervals.
+// Overlapping and adjacent intervals are merged on insertion, so the
+// result doesn't depend on the order of the inserts.
+// The zero value is an empty set ready to use.
+type Set struct {
+	intervals []Interval
+}
+
+// New creates a Set containing the given intervals.
+func New(intervals ...Interval) *Set {
+	s := &Set{}
+	for _, iv := range intervals {
+		s.Insert(iv.Start, iv.End)
+	}
+	return s
+}
+
+// Insert adds the integers from 'start' to 'end' inclusive to the set.
+// NOTE: an interval with start > end is empty so nothing is added.
+func (s *Set) Insert(start, end bignum.Int) {
+
+	if end.Cmp(start) < 0 {
+		return
+	}
+
+	// first interval which overlaps or touches the new one from the left
+	startMinusOne := start.Dec()
+	first := sort.Search(len(s.intervals), func(i int) bool {
+		return s.intervals[i].End.Cmp(startMinusOne) >= 0
+	})
+
+	// first interval after the new one with a gap in between
+	endPlusOne := end.Inc()
+	last := first
+	for last < len(s.intervals) && s.intervals[last].Start.Cmp(endPlusOne) <= 0 {
+		last++
+	}
+
+	merged := Interval{Start: start, End: end}
+	if first < last {
+		if s.intervals[first].Start.Cmp(merged.Start) < 0 {
+			merged.Start = s.intervals[first].Start
+		}
+		if s.intervals[last-1].End.Cmp(merged.End) > 0 {
+			merged.End = s.intervals[last-1].End
+		}
+	}
+
+	s.intervals = append(s.intervals[:first], append([]Interval{merged}, s.intervals[last:]...)...)
+}
+
+// Union returns a new set containing every integer from both sets.
+func (s *Set) Union(other *Set) *Set {
+
+	result := &Set{intervals: make([]Interval, 0, len(s.intervals)+len(other.intervals))}
+
+	// merge the two sorted lists, then let Insert deal with the overlaps
+	// which only ever touch the last interval
+	i, j := 0, 0
+	for i < len(s.intervals) || j < len(other.intervals) {
+		var next Interval
+		if j == len(other.intervals) || (i < len(s.intervals) && s.intervals[i].Start.Cmp(other.intervals[j].Start) <= 0) {
+			next = s.intervals[i]
+			i++
+		} else {
+			next = other.intervals[j]
+			j++
+		}
+		result.Insert(next.Start, next.End)
+	}
+
+	return result
+}
+
+// Contains reports whether 'x' is in the set. O(log n)
+func (s *Set) Contains(x bignum.Int) bool {
+
+	idx := sort.Search(len(s.intervals), func(i int) bool {
+		return s.intervals[i].End.Cmp(x) >= 0
+	})
+
+	return idx < len(s.intervals) && s.intervals[idx].Start.Cmp(x) <= 0
+}
+
+// Cardinality returns the number of integers in the set.
+func (s *Set) Cardinality() bignum.Int {
+	var count bignum.Int
+	for _, iv := range s.intervals {
+		count = count.Add(iv.Count())
+	}
+	return count
+}
+
+// Intervals returns the merged intervals in increasing order.
+func (s *Set) Intervals() []Interval {
+	temp := make([]Interval, len(s.intervals))
+	copy(temp, s.intervals)
+	return temp
+}
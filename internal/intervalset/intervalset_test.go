@@ -0,0 +1,78 @@
+package intervalset
+
+import (
+	"testing"
+
+	"github.com/rawbits2010/AoC25/internal/bignum"
+)
+
+func iv(start, end string) Interval {
+	return Interval{Start: bignum.MustParse(start), End: bignum.MustParse(end)}
+}
+
+func checkIntervals(t *testing.T, s *Set, expected ...Interval) {
+	t.Helper()
+	got := s.Intervals()
+	if len(got) != len(expected) {
+		t.Fatalf("got %d intervals %v, expected %d", len(got), got, len(expected))
+	}
+	for i := range got {
+		if got[i].Start.Cmp(expected[i].Start) != 0 || got[i].End.Cmp(expected[i].End) != 0 {
+			t.Errorf("interval %d: got %s-%s, expected %s-%s", i, got[i].Start, got[i].End, expected[i].Start, expected[i].End)
+		}
+	}
+}
+
+func TestInsert(t *testing.T) {
+
+	// the puzzle example
+	s := New(iv("3", "5"), iv("10", "14"), iv("16", "20"), iv("12", "18"))
+	checkIntervals(t, s, iv("3", "5"), iv("10", "20"))
+
+	// order must not matter
+	s = New(iv("12", "18"), iv("16", "20"), iv("10", "14"), iv("3", "5"))
+	checkIntervals(t, s, iv("3", "5"), iv("10", "20"))
+
+	// adjacent ranges merge, swallowed ones disappear
+	s = New(iv("1", "2"), iv("7", "8"), iv("3", "4"), iv("20", "30"), iv("0", "100"), iv("200", "200"))
+	checkIntervals(t, s, iv("0", "100"), iv("200", "200"))
+
+	// empty interval is ignored
+	s.Insert(bignum.MustParse("500"), bignum.MustParse("400"))
+	checkIntervals(t, s, iv("0", "100"), iv("200", "200"))
+}
+
+func TestContainsAndCardinality(t *testing.T) {
+
+	s := New(iv("3", "5"), iv("10", "14"), iv("16", "20"), iv("12", "18"), iv("100000000000000000000", "100000000000000000009"))
+
+	for _, id := range []string{"3", "5", "10", "17", "20", "100000000000000000005"} {
+		if !s.Contains(bignum.MustParse(id)) {
+			t.Errorf("expected %s to be in the set", id)
+		}
+	}
+	for _, id := range []string{"0", "2", "6", "9", "21", "99999999999999999999", "100000000000000000010"} {
+		if s.Contains(bignum.MustParse(id)) {
+			t.Errorf("expected %s not to be in the set", id)
+		}
+	}
+
+	if got := s.Cardinality().String(); got != "24" {
+		t.Errorf("cardinality: got %s, expected 24", got)
+	}
+	if got := (&Set{}).Cardinality().String(); got != "0" {
+		t.Errorf("cardinality of empty set: got %s, expected 0", got)
+	}
+}
+
+func TestUnion(t *testing.T) {
+
+	a := New(iv("1", "5"), iv("20", "25"), iv("40", "40"))
+	b := New(iv("6", "10"), iv("22", "30"), iv("50", "60"))
+
+	checkIntervals(t, a.Union(b), iv("1", "10"), iv("20", "30"), iv("40", "40"), iv("50", "60"))
+
+	// operands stay untouched
+	checkIntervals(t, a, iv("1", "5"), iv("20", "25"), iv("40", "40"))
+	checkIntervals(t, b, iv("6", "10"), iv("22", "30"), iv("50", "60"))
+}
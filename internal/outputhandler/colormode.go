@@ -0,0 +1,64 @@
+package outputhandler
+
+import (
+	"os"
+	"strings"
+)
+
+// ColorMode tells whether to use colors, or to detect it.
+type ColorMode int
+
+const (
+	// ColorAuto is using colors if the terminal supports them
+	ColorAuto ColorMode = iota
+	// ColorAlways is using colors even if not writing to a terminal
+	ColorAlways
+	// ColorNever is plain text only
+	ColorNever
+)
+
+// colorMode is the override set by SetColorMode
+var colorMode = ColorAuto
+
+// envColorMode and envColorDepth is what NO_COLOR and FORCE_COLOR
+// say, read by Initialize
+var envColorMode = ColorAuto
+var envColorDepth = Colors16
+
+// SetColorMode overrides the detection and the environment variables,
+// like for a '--color' option or in tests. ColorAuto goes back to them.
+func SetColorMode(mode ColorMode) {
+	colorMode = mode
+}
+
+// GetColorMode returns the mode set by SetColorMode.
+func GetColorMode() ColorMode {
+	return colorMode
+}
+
+// colorModeFromEnvironment reads the NO_COLOR and FORCE_COLOR variables.
+// FORCE_COLOR wins if both are set, it's the more specific ask.
+// Its level also sets the color depth: 1 is 16 colors, 2 is 256 and 3 is truecolor.
+// Like for NO_COLOR, an empty value is the same as not set.
+func colorModeFromEnvironment() (ColorMode, ColorDepth) {
+
+	if force := strings.ToLower(strings.TrimSpace(os.Getenv("FORCE_COLOR"))); force != "" {
+		switch force {
+		case "0", "false":
+			return ColorNever, Colors16
+		case "2":
+			return ColorAlways, Colors256
+		case "3":
+			return ColorAlways, TrueColor
+		default:
+			return ColorAlways, Colors16
+		}
+	}
+
+	// any value but the empty string counts
+	if os.Getenv("NO_COLOR") != "" {
+		return ColorNever, Colors16
+	}
+
+	return ColorAuto, Colors16
+}
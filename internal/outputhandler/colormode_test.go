@@ -0,0 +1,70 @@
+package outputhandler
+
+import "testing"
+
+func TestColorModeFromEnvironment(t *testing.T) {
+
+	cases := []struct {
+		noColor, forceColor string
+		mode                ColorMode
+		depth               ColorDepth
+	}{
+		{"", "", ColorAuto, Colors16},
+		{"1", "", ColorNever, Colors16},
+		{"", "1", ColorAlways, Colors16},
+		{"", "2", ColorAlways, Colors256},
+		{"", "3", ColorAlways, TrueColor},
+		{"", "0", ColorNever, Colors16},
+		{"1", "true", ColorAlways, Colors16},
+	}
+
+	for _, c := range cases {
+		t.Setenv("NO_COLOR", c.noColor)
+		// set even if empty, that is the same as not set for both
+		t.Setenv("FORCE_COLOR", c.forceColor)
+
+		mode, depth := colorModeFromEnvironment()
+		if mode != c.mode || depth != c.depth {
+			t.Errorf("NO_COLOR=%q FORCE_COLOR=%q: expected %d %s, got %d %s", c.noColor, c.forceColor, c.mode, c.depth, mode, depth)
+		}
+	}
+}
+
+func TestSetColorMode(t *testing.T) {
+
+	withTerminal(t, TerminalInfo{CSIColorSupport: true})
+	t.Cleanup(func() {
+		SetColorMode(ColorAuto)
+		envColorMode = ColorAuto
+	})
+
+	if !CanUseColors() {
+		t.Errorf("expected colors on the terminal")
+	}
+
+	envColorMode = ColorNever // NO_COLOR
+	if CanUseColors() {
+		t.Errorf("expected no colors with NO_COLOR")
+	}
+
+	SetColorMode(ColorAlways)
+	if !CanUseColors() || GetReset() == "" {
+		t.Errorf("expected colors when forced")
+	}
+
+	SetColorMode(ColorNever)
+	envColorMode = ColorAlways // FORCE_COLOR
+	if CanUseColors() || GetForeground(Red) != "" {
+		t.Errorf("expected no colors when turned off")
+	}
+}
+
+func TestNoColorsWithoutTerminal(t *testing.T) {
+
+	withTerminal(t, TerminalInfo{CSIColorSupport: true, CSICursorSupport: true})
+	stdoutIsTerminal = false
+
+	if CanUseColors() || CanUseCursorControl() {
+		t.Errorf("expected no colors and cursor control when not writing to a terminal")
+	}
+}
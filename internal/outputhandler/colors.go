@@ -0,0 +1,215 @@
+package outputhandler
+
+import (
+	"strconv"
+)
+
+// ColorDepth is how many colors the terminal can show.
+type ColorDepth int
+
+const (
+	// Colors16 is the basic SGR colors - the TerminalColor values
+	Colors16 ColorDepth = iota
+	// Colors256 is the xterm 256 color palette
+	Colors256
+	// TrueColor is 24-bit RGB
+	TrueColor
+)
+
+func (d ColorDepth) String() string {
+	switch d {
+	case Colors256:
+		return "256 colors"
+	case TrueColor:
+		return "truecolor"
+	default:
+		return "16 colors"
+	}
+}
+
+// GetColorDepth returns the best color depth the detected terminal supports,
+// or the one FORCE_COLOR asks for if higher.
+// Every terminal with colors is expected to do at least the 16 basic ones.
+func GetColorDepth() ColorDepth {
+	return max(detectedTerminal.ColorDepth, detectedEnvironment.AddsColorDepth, envColorDepth)
+}
+
+// Color is anything GetColor can use: a TerminalColor, a PaletteColor or an RGBColor.
+// The colors the terminal doesn't support are converted to the closest one it does.
+type Color interface {
+	fgCode(depth ColorDepth) string
+	bgCode(depth ColorDepth) string
+}
+
+// PaletteColor is an index into the xterm 256 color palette.
+// 0-15 are the basic colors, 16-231 is a 6x6x6 color cube,
+// and 232-255 is a grayscale ramp.
+type PaletteColor uint8
+
+func (c PaletteColor) fgCode(depth ColorDepth) string {
+	if depth < Colors256 {
+		return c.basic().fgCode(depth)
+	}
+	return "38;5;" + strconv.Itoa(int(c))
+}
+
+func (c PaletteColor) bgCode(depth ColorDepth) string {
+	if depth < Colors256 {
+		return c.basic().bgCode(depth)
+	}
+	return "48;5;" + strconv.Itoa(int(c))
+}
+
+// RGB returns the color of the palette entry.
+func (c PaletteColor) RGB() RGBColor {
+	switch {
+	case c < 16:
+		return basicColorRGB[paletteBasicColors[c]]
+	case c < 232:
+		i := int(c) - 16
+		return RGBColor{cubeLevels[i/36], cubeLevels[i/6%6], cubeLevels[i%6]}
+	default:
+		v := uint8(8 + 10*(int(c)-232))
+		return RGBColor{v, v, v}
+	}
+}
+
+func (c PaletteColor) basic() TerminalColor {
+	if c < 16 {
+		return paletteBasicColors[c]
+	}
+	return c.RGB().basic()
+}
+
+// RGBColor is a 24-bit color.
+type RGBColor struct {
+	R, G, B uint8
+}
+
+// RGB is a shorthand for making an RGBColor.
+func RGB(r, g, b uint8) RGBColor {
+	return RGBColor{r, g, b}
+}
+
+func (c RGBColor) fgCode(depth ColorDepth) string {
+	switch depth {
+	case TrueColor:
+		return "38;2;" + c.sgrParams()
+	case Colors256:
+		return c.Palette().fgCode(depth)
+	default:
+		return c.basic().fgCode(depth)
+	}
+}
+
+func (c RGBColor) bgCode(depth ColorDepth) string {
+	switch depth {
+	case TrueColor:
+		return "48;2;" + c.sgrParams()
+	case Colors256:
+		return c.Palette().bgCode(depth)
+	default:
+		return c.basic().bgCode(depth)
+	}
+}
+
+func (c RGBColor) sgrParams() string {
+	return strconv.Itoa(int(c.R)) + ";" + strconv.Itoa(int(c.G)) + ";" + strconv.Itoa(int(c.B))
+}
+
+// Palette returns the closest color of the 256 color palette,
+// from the color cube or the grayscale ramp.
+// NOTE: the basic colors are left out, terminals like to change those.
+func (c RGBColor) Palette() PaletteColor {
+
+	cubeIdx := func(v uint8) int {
+		switch {
+		case v < 48:
+			return 0
+		case v < 115:
+			return 1
+		default:
+			return (int(v) - 35) / 40
+		}
+	}
+	r, g, b := cubeIdx(c.R), cubeIdx(c.G), cubeIdx(c.B)
+	cube := PaletteColor(16 + 36*r + 6*g + b)
+
+	avg := (int(c.R) + int(c.G) + int(c.B)) / 3
+	grayIdx := 23
+	if avg < 238 {
+		grayIdx = max(avg-3, 0) / 10
+	}
+	gray := PaletteColor(232 + grayIdx)
+
+	if c.distance(gray.RGB()) < c.distance(cube.RGB()) {
+		return gray
+	}
+	return cube
+}
+
+// basic returns the closest of the 16 basic colors.
+func (c RGBColor) basic() TerminalColor {
+
+	closest := Black
+	closestDist := -1
+	for _, color := range paletteBasicColors {
+		dist := c.distance(basicColorRGB[color])
+		if closestDist < 0 || dist < closestDist {
+			closest = color
+			closestDist = dist
+		}
+	}
+
+	return closest
+}
+
+// distance is the squared distance of the colors, weighted
+// a bit for how the eye sees them - good enough for picking.
+func (c RGBColor) distance(other RGBColor) int {
+	dr := int(c.R) - int(other.R)
+	dg := int(c.G) - int(other.G)
+	db := int(c.B) - int(other.B)
+	return 2*dr*dr + 4*dg*dg + 3*db*db
+}
+
+// Gradient returns the color at 't' between 'from' (t = 0) and 'to' (t = 1),
+// for showing values like intensities with colors.
+func Gradient(from, to RGBColor, t float64) RGBColor {
+
+	t = min(max(t, 0), 1)
+	mix := func(a, b uint8) uint8 {
+		return uint8(float64(a) + (float64(b)-float64(a))*t + 0.5)
+	}
+
+	return RGBColor{mix(from.R, to.R), mix(from.G, to.G), mix(from.B, to.B)}
+}
+
+var cubeLevels = [6]uint8{0, 95, 135, 175, 215, 255}
+
+// paletteBasicColors are the first 16 entries of the palette.
+var paletteBasicColors = [16]TerminalColor{
+	Black, Red, Green, Yellow, Blue, Magenta, Cyan, Gray,
+	DarkGray, BrightRed, BrightGreen, BrightYellow, BrightBlue, BrightMagenta, BrightCyan, White,
+}
+
+// basicColorRGB is how xterm shows the basic colors by default.
+// NOTE: every terminal has its own idea about these, and users can change them.
+var basicColorRGB = map[TerminalColor]RGBColor{
+	Black:         {0, 0, 0},
+	Red:           {205, 0, 0},
+	Green:         {0, 205, 0},
+	Yellow:        {205, 205, 0},
+	Blue:          {0, 0, 238},
+	Magenta:       {205, 0, 205},
+	Cyan:          {0, 205, 205},
+	Gray:          {229, 229, 229},
+	DarkGray:      {127, 127, 127},
+	BrightRed:     {255, 0, 0},
+	BrightGreen:   {0, 255, 0},
+	BrightYellow:  {255, 255, 0},
+	BrightBlue:    {92, 92, 255},
+	BrightMagenta: {255, 0, 255},
+	BrightCyan:    {0, 255, 255},
+	White:         {255, 255, 255},
+}
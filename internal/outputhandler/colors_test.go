@@ -0,0 +1,93 @@
+package outputhandler
+
+import (
+	"testing"
+)
+
+// withTerminal sets the detected terminal for the test,
+// as if writing to it.
+func withTerminal(t *testing.T, terminal TerminalInfo) {
+	origTerminal, origIsTerminal, origProcessing := detectedTerminal, stdoutIsTerminal, terminalCommandProcessing
+	detectedTerminal, stdoutIsTerminal, terminalCommandProcessing = terminal, true, true
+	t.Cleanup(func() {
+		detectedTerminal, stdoutIsTerminal, terminalCommandProcessing = origTerminal, origIsTerminal, origProcessing
+	})
+}
+
+func TestGetColorDepthFallback(t *testing.T) {
+
+	cases := []struct {
+		depth    ColorDepth
+		color    Color
+		expected string
+	}{
+		{TrueColor, RGB(255, 128, 0), "\033[38;2;255;128;0;49m"},
+		{Colors256, RGB(255, 128, 0), "\033[38;5;208;49m"},
+		{Colors16, RGB(255, 128, 0), "\033[33;49m"},
+		{TrueColor, PaletteColor(196), "\033[38;5;196;49m"},
+		{Colors16, PaletteColor(196), "\033[91;49m"},
+		{Colors16, PaletteColor(4), "\033[34;49m"},
+		{TrueColor, Red, "\033[31;49m"},
+	}
+
+	for _, c := range cases {
+		withTerminal(t, TerminalInfo{CSIColorSupport: true, ColorDepth: c.depth})
+		if got := GetForeground(c.color); got != c.expected {
+			t.Errorf("%v at %s: expected %q, got %q", c.color, c.depth, c.expected, got)
+		}
+	}
+
+	withTerminal(t, TerminalInfo{CSIColorSupport: true, ColorDepth: Colors256})
+	if got := GetBackground(RGB(0, 0, 0)); got != "\033[39;48;5;16m" {
+		t.Errorf("background: got %q", got)
+	}
+
+	withTerminal(t, TerminalInfo{})
+	if got := GetForeground(RGB(255, 128, 0)); got != "" {
+		t.Errorf("no colors: got %q", got)
+	}
+}
+
+func TestPalette(t *testing.T) {
+
+	cases := map[RGBColor]PaletteColor{
+		{0, 0, 0}:       16,
+		{255, 255, 255}: 231,
+		{255, 0, 0}:     196,
+		{128, 128, 128}: 244,
+		{8, 8, 8}:       232,
+		{95, 135, 175}:  67,
+	}
+
+	for rgb, expected := range cases {
+		if got := rgb.Palette(); got != expected {
+			t.Errorf("%v: expected %d, got %d", rgb, expected, got)
+		}
+	}
+
+	// every palette entry of the cube and the ramp finds itself
+	for i := 16; i < 256; i++ {
+		c := PaletteColor(i)
+		if got := c.RGB().Palette(); got.RGB() != c.RGB() {
+			t.Errorf("%d: got %d", i, got)
+		}
+	}
+}
+
+func TestGradient(t *testing.T) {
+
+	from, to := RGB(0, 0, 0), RGB(255, 100, 10)
+
+	if got := Gradient(from, to, 0); got != from {
+		t.Errorf("start: got %v", got)
+	}
+	if got := Gradient(from, to, 1); got != to {
+		t.Errorf("end: got %v", got)
+	}
+	if got := Gradient(from, to, 0.5); got != RGB(128, 50, 5) {
+		t.Errorf("middle: got %v", got)
+	}
+	if got := Gradient(from, to, 2); got != to {
+		t.Errorf("over: got %v", got)
+	}
+}
@@ -0,0 +1,91 @@
+package outputhandler
+
+import (
+	"fmt"
+	"io"
+	"os"
+)
+
+// cursorOutput is where the cursor control sequences go.
+var cursorOutput io.Writer = os.Stdout
+
+var cursorHidden = false
+var alternateScreen = false
+
+// writeCursorControl writes the sequence if cursor control is enabled,
+// so all the cursor functions are no-ops when it's not.
+// Note: some terminals may not make use of / correctly implement CSI.
+func writeCursorControl(sequence string) bool {
+	if !CanUseCursorControl() {
+		return false
+	}
+	fmt.Fprint(cursorOutput, sequence)
+	return true
+}
+
+// MoveCursorTo moves the cursor to the row and column, both counted from 1.
+func MoveCursorTo(row, col int) {
+	writeCursorControl(fmt.Sprintf("\033[%d;%dH", max(row, 1), max(col, 1)))
+}
+
+// SaveCursor saves the cursor position, to go back there with RestoreCursor.
+func SaveCursor() {
+	writeCursorControl("\0337")
+}
+
+// RestoreCursor moves the cursor back to where SaveCursor saved it.
+func RestoreCursor() {
+	writeCursorControl("\0338")
+}
+
+// HideCursor hides the cursor, so it doesn't jump around while redrawing.
+// Reset shows it again.
+func HideCursor() {
+	if writeCursorControl("\033[?25l") {
+		cursorHidden = true
+	}
+}
+
+// ShowCursor shows the cursor hidden by HideCursor.
+func ShowCursor() {
+	if writeCursorControl("\033[?25h") {
+		cursorHidden = false
+	}
+}
+
+// ClearLine clears the whole line the cursor is in. The cursor stays where it is.
+func ClearLine() {
+	writeCursorControl("\033[2K")
+}
+
+// ClearScreen clears the screen and moves the cursor to the top left corner.
+func ClearScreen() {
+	writeCursorControl("\033[2J\033[H")
+}
+
+// EnterAlternateScreen switches to the alternate screen buffer, where an
+// animation can be drawn without messing up the output before it.
+// Reset switches back to the normal screen.
+func EnterAlternateScreen() {
+	if writeCursorControl("\033[?1049h") {
+		alternateScreen = true
+	}
+}
+
+// ExitAlternateScreen switches back to the normal screen buffer, as it was
+// before EnterAlternateScreen.
+func ExitAlternateScreen() {
+	if writeCursorControl("\033[?1049l") {
+		alternateScreen = false
+	}
+}
+
+// resetCursor undoes the cursor changes still in effect.
+func resetCursor() {
+	if alternateScreen {
+		ExitAlternateScreen()
+	}
+	if cursorHidden {
+		ShowCursor()
+	}
+}
@@ -0,0 +1,59 @@
+package outputhandler
+
+import (
+	"strings"
+	"testing"
+)
+
+func captureCursorOutput(t *testing.T) *strings.Builder {
+	var sb strings.Builder
+	orig := cursorOutput
+	cursorOutput = &sb
+	t.Cleanup(func() {
+		cursorOutput = orig
+	})
+	return &sb
+}
+
+func TestCursorControl(t *testing.T) {
+
+	out := captureCursorOutput(t)
+	withTerminal(t, TerminalInfo{CSICursorSupport: true})
+
+	MoveCursorTo(3, 7)
+	SaveCursor()
+	RestoreCursor()
+	ClearLine()
+	ClearScreen()
+	HideCursor()
+	EnterAlternateScreen()
+	resetCursor()
+
+	expected := "\033[3;7H\0337\0338\033[2K\033[2J\033[H\033[?25l\033[?1049h\033[?1049l\033[?25h"
+	if out.String() != expected {
+		t.Errorf("expected %q, got %q", expected, out.String())
+	}
+	if cursorHidden || alternateScreen {
+		t.Errorf("the cursor changes were not undone")
+	}
+}
+
+func TestCursorControlDisabled(t *testing.T) {
+
+	out := captureCursorOutput(t)
+	withTerminal(t, TerminalInfo{CSIColorSupport: true})
+
+	MoveCursorTo(1, 1)
+	SaveCursor()
+	RestoreCursor()
+	HideCursor()
+	ShowCursor()
+	ClearLine()
+	ClearScreen()
+	EnterAlternateScreen()
+	ExitAlternateScreen()
+
+	if out.Len() != 0 {
+		t.Errorf("expected no output, got %q", out.String())
+	}
+}
@@ -1,6 +1,14 @@
 // outputhandler package provides some basic CSI functionality
 // along with basic terminal detection.
 //
+// The detection is platform specific. On Windows the process tree is
+// checked for known terminals, on Linux and macOS it's the process tree
+// where /proc is available, and the TERM and COLORTERM variables.
+//
+// Colors are only used when the output is a terminal. The NO_COLOR and
+// FORCE_COLOR variables are respected, see https://no-color.org and
+// https://force-color.org, and SetColorMode overrides all of these.
+//
 // NOTE: This package is not meant to be feature complete or
 // optimal in any way. It is here to spice up the solutions a bit.
 package outputhandler
@@ -8,33 +16,27 @@ package outputhandler
 import (
 	"fmt"
 	"os"
-	"runtime"
 	"strconv"
-
-	"golang.org/x/sys/windows"
 )
 
 var detectedTerminal TerminalInfo
 var detectedEnvironment RunningEnvironment
 
 var terminalCommandProcessing = true
-var origTerminalMode uint32
+var stdoutIsTerminal = false
 
 // Initialize sets up the output for color text
 func Initialize() {
 
-	if runtime.GOOS == "windows" {
-		// enable Virtual Terminal Processing by adding the flag to the current mode
-		fd := windows.Handle(os.Stdout.Fd())
-		if err := windows.GetConsoleMode(fd, &origTerminalMode); err != nil {
-			fmt.Printf("Warning: couldn't enable Virtual Terminal Processing: %v", err)
-			terminalCommandProcessing = false
-		} else {
-			if err = windows.SetConsoleMode(fd, origTerminalMode|windows.ENABLE_VIRTUAL_TERMINAL_PROCESSING); err != nil {
-				fmt.Printf("Warning: couldn't enable Virtual Terminal Processing: %v", err)
-				terminalCommandProcessing = false
-			}
-		}
+	stdoutIsTerminal = isTerminal(os.Stdout)
+	envColorMode, envColorDepth = colorModeFromEnvironment()
+
+	// there is nothing to enable when writing into a file or a pipe
+	if !stdoutIsTerminal {
+		terminalCommandProcessing = false
+	} else if err := enableTerminalProcessing(); err != nil {
+		fmt.Printf("Warning: couldn't enable Virtual Terminal Processing: %v", err)
+		terminalCommandProcessing = false
 	}
 
 	terminal, env, err := GetTerminalInfo()
@@ -46,14 +48,12 @@ func Initialize() {
 	}
 }
 
-// Reset sets the terminal mode back how Initialize() found it
+// Reset sets the terminal mode back how Initialize() found it,
+// including the cursor and the screen buffer
 func Reset() {
+	resetCursor()
 	fmt.Println(GetReset())
-	if runtime.GOOS == "windows" {
-		// reset terminal mode
-		fd := windows.Handle(os.Stdout.Fd())
-		windows.SetConsoleMode(fd, origTerminalMode)
-	}
+	restoreTerminalMode()
 }
 
 // TerminalColor is the actual terminal color values for bash
@@ -99,24 +99,39 @@ var colorCodeBases = map[TerminalColor]int{
 	Black:         30,
 }
 
-func getFGCode(color TerminalColor) string {
-	return strconv.Itoa(colorCodeBases[color])
+func (c TerminalColor) fgCode(depth ColorDepth) string {
+	return strconv.Itoa(colorCodeBases[c])
 }
 
-func getBGCode(color TerminalColor) string {
-	return strconv.Itoa(colorCodeBases[color] + 10)
+func (c TerminalColor) bgCode(depth ColorDepth) string {
+	return strconv.Itoa(colorCodeBases[c] + 10)
 }
 
 // CanUseColors can be used to determine if colors are enabled in terminal.
+// SetColorMode, then FORCE_COLOR and NO_COLOR decide first if not empty,
+// and there are no colors if the output is not a terminal.
 // Although not even close to accurate. :)
 func CanUseColors() bool {
-	return (detectedTerminal.CSIColorSupport || detectedEnvironment.AddsCSIColorSupport) && terminalCommandProcessing
+
+	mode := colorMode
+	if mode == ColorAuto {
+		mode = envColorMode
+	}
+	switch mode {
+	case ColorAlways:
+		return true
+	case ColorNever:
+		return false
+	}
+
+	return (detectedTerminal.CSIColorSupport || detectedEnvironment.AddsCSIColorSupport) && stdoutIsTerminal && terminalCommandProcessing
 }
 
 // CanUseCursorControl can be used to determine if cursor control processing is enabled in terminal.
+// There is no cursor if the output is not a terminal.
 // Although not even close to accurate. :)
 func CanUseCursorControl() bool {
-	return detectedTerminal.CSICursorSupport || detectedEnvironment.AddsCSICursorSupport
+	return (detectedTerminal.CSICursorSupport || detectedEnvironment.AddsCSICursorSupport) && stdoutIsTerminal && terminalCommandProcessing
 }
 
 // CanUseEmojis can be used to determine if emojis are displayed correctly in terminal.
@@ -125,35 +140,34 @@ func CanUseEmojis() bool {
 	return detectedTerminal.EmojiSupport || detectedEnvironment.AddsEmojiSupport
 }
 
-// GetTerminalColor returns the format string for the requested foreground color.
+// GetForeground returns the format string for the requested foreground color.
 // Resets background to default color.
+// The color is converted down if the terminal doesn't support it.
 // Note: some terminals may not make use of / correctly implement CSI.
-func GetForeground(color TerminalColor) string {
-	if !CanUseColors() {
-		return ""
-	}
-	return "\033[" + getFGCode(color) + ";" + getBGCode(DefaultColor) + "m"
+func GetForeground(color Color) string {
+	return GetColor(color, DefaultColor)
 }
 
 // GetBackground returns the format string for the requested background color.
 // Resets foreground to default color.
 // Using bright colors might make the foreground color black in some terminals.
+// The color is converted down if the terminal doesn't support it.
 // Note: some terminals may not make use of / correctly implement CSI.
-func GetBackground(color TerminalColor) string {
-	if !CanUseColors() {
-		return ""
-	}
-	return "\033[" + getFGCode(DefaultColor) + ";" + getBGCode(color) + "m"
+func GetBackground(color Color) string {
+	return GetColor(DefaultColor, color)
 }
 
 // GetColor returns the format string for the requested foreground and background color.
 // Using bright background colors might make the foreground color black in some terminals.
+// The colors are converted down if the terminal doesn't support them.
+// For bold, underline and such with the colors, see Style.
 // Note: some terminals may not make use of / correctly implement CSI.
-func GetColor(foregroundColor TerminalColor, backgroundColor TerminalColor) string {
+func GetColor(foregroundColor Color, backgroundColor Color) string {
 	if !CanUseColors() {
 		return ""
 	}
-	return "\033[" + getFGCode(foregroundColor) + ";" + getBGCode(backgroundColor) + "m"
+	depth := GetColorDepth()
+	return "\033[" + foregroundColor.fgCode(depth) + ";" + backgroundColor.bgCode(depth) + "m"
 }
 
 // GetReset returns the format string that resets the every format setting.
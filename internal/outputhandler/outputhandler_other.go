@@ -0,0 +1,26 @@
+//go:build !windows && !linux && !darwin && !freebsd && !netbsd && !openbsd && !dragonfly
+
+package outputhandler
+
+import "os"
+
+// NOTE: nothing is detected here, so only plain text is used.
+
+var knowEnvironments = []RunningEnvironment{}
+var knownTerminals = []TerminalInfo{}
+
+func enableTerminalProcessing() error {
+	return nil
+}
+
+func restoreTerminalMode() {}
+
+func GetProcesses() (*map[uint32]ProcessInfo, error) {
+	return nil, ErrNoProcessList
+}
+
+func detectFromEnvironment(terminal *TerminalInfo) {}
+
+func isTerminal(file *os.File) bool {
+	return false
+}
@@ -0,0 +1,12 @@
+//go:build linux || darwin || freebsd || netbsd || openbsd || dragonfly
+
+package outputhandler
+
+// enableTerminalProcessing has nothing to do, the terminals
+// process the escape sequences without asking.
+func enableTerminalProcessing() error {
+	return nil
+}
+
+// restoreTerminalMode has nothing to restore.
+func restoreTerminalMode() {}
@@ -0,0 +1,35 @@
+package outputhandler
+
+import (
+	"os"
+
+	"golang.org/x/sys/windows"
+)
+
+var origTerminalMode uint32
+
+// enableTerminalProcessing enables Virtual Terminal Processing
+// by adding the flag to the current console mode.
+func enableTerminalProcessing() error {
+
+	fd := windows.Handle(os.Stdout.Fd())
+	if err := windows.GetConsoleMode(fd, &origTerminalMode); err != nil {
+		return err
+	}
+
+	return windows.SetConsoleMode(fd, origTerminalMode|windows.ENABLE_VIRTUAL_TERMINAL_PROCESSING)
+}
+
+// restoreTerminalMode sets back the console mode enableTerminalProcessing found.
+func restoreTerminalMode() {
+	fd := windows.Handle(os.Stdout.Fd())
+	windows.SetConsoleMode(fd, origTerminalMode)
+}
+
+// isTerminal tells if the file is a console.
+// NOTE: mintty (Git bash in its own window) uses pipes instead of a console,
+// so it is not one - though Virtual Terminal Processing can't be enabled there anyway.
+func isTerminal(file *os.File) bool {
+	var mode uint32
+	return windows.GetConsoleMode(windows.Handle(file.Fd()), &mode) == nil
+}
@@ -0,0 +1,98 @@
+package outputhandler
+
+import (
+	"strings"
+)
+
+// Attribute is a text attribute, they can be combined like Bold|Underline.
+// Note: not every terminal shows all of them, italic and strikethrough are often missing.
+type Attribute uint8
+
+const (
+	Bold Attribute = 1 << iota
+	Dim
+	Italic
+	Underline
+	Reverse
+	Strikethrough
+)
+
+// attributeCodes are the SGR codes of the attributes, in the order of the bits.
+var attributeCodes = []struct {
+	attr Attribute
+	code string
+}{
+	{Bold, "1"},
+	{Dim, "2"},
+	{Italic, "3"},
+	{Underline, "4"},
+	{Reverse, "7"},
+	{Strikethrough, "9"},
+}
+
+// Style is the look of the text - the colors and the attributes.
+// The zero value is the default look of the terminal.
+//
+// Like: outputhandler.Style{Foreground: outputhandler.Green, Attributes: outputhandler.Bold}
+type Style struct {
+	// Foreground is the text color, nil is the default
+	Foreground Color
+	// Background is the color behind the text, nil is the default
+	Background Color
+	Attributes Attribute
+}
+
+// WithForeground returns the style with the text color changed.
+func (s Style) WithForeground(color Color) Style {
+	s.Foreground = color
+	return s
+}
+
+// WithBackground returns the style with the background color changed.
+func (s Style) WithBackground(color Color) Style {
+	s.Background = color
+	return s
+}
+
+// With returns the style with the attributes added.
+func (s Style) With(attrs Attribute) Style {
+	s.Attributes |= attrs
+	return s
+}
+
+// GetStyle returns the format string for the style as one SGR sequence.
+// It starts from the default look, so nothing stays from the previous style.
+// Returns an empty string if colors are not enabled - the text stays plain.
+// Note: some terminals may not make use of / correctly implement CSI.
+func GetStyle(style Style) string {
+
+	if !CanUseColors() {
+		return ""
+	}
+
+	codes := []string{"0"}
+	for _, ac := range attributeCodes {
+		if style.Attributes&ac.attr != 0 {
+			codes = append(codes, ac.code)
+		}
+	}
+
+	depth := GetColorDepth()
+	if style.Foreground != nil {
+		codes = append(codes, style.Foreground.fgCode(depth))
+	}
+	if style.Background != nil {
+		codes = append(codes, style.Background.bgCode(depth))
+	}
+
+	return "\033[" + strings.Join(codes, ";") + "m"
+}
+
+// Render returns the text in the style, with the reset after it.
+// The text is returned as it is if colors are not enabled.
+func (s Style) Render(text string) string {
+	if !CanUseColors() {
+		return text
+	}
+	return GetStyle(s) + text + GetReset()
+}
@@ -0,0 +1,45 @@
+package outputhandler
+
+import (
+	"testing"
+)
+
+func TestGetStyle(t *testing.T) {
+
+	withTerminal(t, TerminalInfo{CSIColorSupport: true, ColorDepth: TrueColor})
+
+	cases := []struct {
+		style    Style
+		expected string
+	}{
+		{Style{}, "\033[0m"},
+		{Style{Attributes: Bold | Underline}, "\033[0;1;4m"},
+		{Style{Foreground: Green, Attributes: Bold}, "\033[0;1;32m"},
+		{Style{}.With(Dim).With(Strikethrough).WithBackground(Blue), "\033[0;2;9;44m"},
+		{Style{Attributes: Italic | Reverse}.WithForeground(RGB(1, 2, 3)).WithBackground(PaletteColor(200)), "\033[0;3;7;38;2;1;2;3;48;5;200m"},
+	}
+
+	for _, c := range cases {
+		if got := GetStyle(c.style); got != c.expected {
+			t.Errorf("%+v: expected %q, got %q", c.style, c.expected, got)
+		}
+	}
+
+	style := Style{Foreground: Red, Attributes: Bold}
+	if got := style.Render("answer"); got != "\033[0;1;31manswer\033[0m" {
+		t.Errorf("render: got %q", got)
+	}
+}
+
+func TestStylePlainText(t *testing.T) {
+
+	withTerminal(t, TerminalInfo{})
+
+	style := Style{Foreground: Red, Attributes: Bold | Underline}
+	if got := GetStyle(style); got != "" {
+		t.Errorf("expected no sequence, got %q", got)
+	}
+	if got := style.Render("answer"); got != "answer" {
+		t.Errorf("expected plain text, got %q", got)
+	}
+}
@@ -0,0 +1,208 @@
+//go:build linux || darwin || freebsd || netbsd || openbsd || dragonfly
+
+package outputhandler
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"golang.org/x/sys/unix"
+)
+
+var knowEnvironments = []RunningEnvironment{
+	{
+		Name:                 "VS Code",
+		ExeName:              "code",
+		AddsCSICursorSupport: true,
+		AddsCSIColorSupport:  true,
+		AddsEmojiSupport:     true,
+		AddsColorDepth:       TrueColor,
+	},
+}
+
+// NOTE: these are the process names as in /proc/<pid>/comm,
+// which is cut to 15 characters.
+var knownTerminals = []TerminalInfo{
+	{ // Go debugger
+		Name:             "Delve",
+		ExeName:          "dlv",
+		CSICursorSupport: false,
+		CSIColorSupport:  true,
+		EmojiSupport:     true,
+		ColorDepth:       Colors16,
+	},
+	{
+		Name:             "GNOME Terminal",
+		ExeName:          "gnome-terminal-",
+		CSICursorSupport: true,
+		CSIColorSupport:  true,
+		EmojiSupport:     true,
+		ColorDepth:       TrueColor,
+	},
+	{
+		Name:             "Konsole",
+		ExeName:          "konsole",
+		CSICursorSupport: true,
+		CSIColorSupport:  true,
+		EmojiSupport:     true,
+		ColorDepth:       TrueColor,
+	},
+	{
+		Name:             "xterm",
+		ExeName:          "xterm",
+		CSICursorSupport: true,
+		CSIColorSupport:  true,
+		EmojiSupport:     false,
+		ColorDepth:       Colors256,
+	},
+	{
+		Name:             "Alacritty",
+		ExeName:          "alacritty",
+		CSICursorSupport: true,
+		CSIColorSupport:  true,
+		EmojiSupport:     true,
+		ColorDepth:       TrueColor,
+	},
+	{
+		Name:             "kitty",
+		ExeName:          "kitty",
+		CSICursorSupport: true,
+		CSIColorSupport:  true,
+		EmojiSupport:     true,
+		ColorDepth:       TrueColor,
+	},
+	{ // it passes everything through to the real terminal anyway
+		Name:             "tmux",
+		ExeName:          "tmux: server",
+		CSICursorSupport: true,
+		CSIColorSupport:  true,
+		EmojiSupport:     true,
+		ColorDepth:       Colors256,
+	},
+}
+
+// GetProcesses reads the parent processes of this one from /proc, up to init.
+// Returns ErrNoProcessList if there is no /proc, like on macOS.
+func GetProcesses() (*map[uint32]ProcessInfo, error) {
+
+	if _, err := os.Stat("/proc/self/stat"); err != nil {
+		return nil, ErrNoProcessList
+	}
+
+	processInfo := readParentChain("/proc", uint32(os.Getppid()))
+	return &processInfo, nil
+}
+
+// readParentChain reads the process and its parents from the proc directory.
+// The chain ends at pid 1, or at a process which is gone or can't be parsed -
+// nothing above that can be found anyway.
+func readParentChain(procDir string, pid uint32) map[uint32]ProcessInfo {
+
+	processInfo := make(map[uint32]ProcessInfo)
+	for pid != 0 {
+
+		if _, seen := processInfo[pid]; seen {
+			break
+		}
+
+		content, err := os.ReadFile(filepath.Join(procDir, strconv.FormatUint(uint64(pid), 10), "stat"))
+		if err != nil {
+			break
+		}
+		procInfo, err := parseProcStat(string(content))
+		if err != nil {
+			break
+		}
+		processInfo[pid] = procInfo
+
+		if pid == 1 {
+			break
+		}
+		pid = procInfo.ParentPID
+	}
+
+	return processInfo
+}
+
+// parseProcStat gets the process info from the content of /proc/<pid>/stat
+// which looks like: "1234 (bash) S 1200 ..."
+func parseProcStat(stat string) (ProcessInfo, error) {
+
+	// the name can have spaces and parentheses too, so looking for the last one
+	nameStart := strings.IndexByte(stat, '(')
+	nameEnd := strings.LastIndexByte(stat, ')')
+	if nameStart < 0 || nameEnd < nameStart {
+		return ProcessInfo{}, fmt.Errorf("no process name found")
+	}
+
+	pid, err := strconv.ParseUint(strings.TrimSpace(stat[:nameStart]), 10, 32)
+	if err != nil {
+		return ProcessInfo{}, fmt.Errorf("invalid pid: %w", err)
+	}
+
+	// state, then the parent pid
+	fields := strings.Fields(stat[nameEnd+1:])
+	if len(fields) < 2 {
+		return ProcessInfo{}, fmt.Errorf("no parent pid found")
+	}
+	ppid, err := strconv.ParseUint(fields[1], 10, 32)
+	if err != nil {
+		return ProcessInfo{}, fmt.Errorf("invalid parent pid: %w", err)
+	}
+
+	return ProcessInfo{
+		ExeName:   stat[nameStart+1 : nameEnd],
+		ProcessId: uint32(pid),
+		ParentPID: uint32(ppid),
+	}, nil
+}
+
+// detectFromEnvironment fills in the support of an unknown terminal
+// from the TERM and COLORTERM variables, if the output is a terminal at all.
+func detectFromEnvironment(terminal *TerminalInfo) {
+
+	if !isTerminal(os.Stdout) {
+		return
+	}
+
+	term := os.Getenv("TERM")
+	colorTerm := os.Getenv("COLORTERM")
+	if (term == "" || term == "dumb") && colorTerm == "" {
+		return
+	}
+
+	terminal.Name = term
+	if terminal.Name == "" {
+		terminal.Name = colorTerm
+	}
+
+	// every terminal worth the name does these since the VT100
+	terminal.CSICursorSupport = term != "" && term != "dumb"
+	terminal.CSIColorSupport = colorTerm != "" || strings.Contains(term, "color") ||
+		strings.HasPrefix(term, "xterm") || strings.HasPrefix(term, "screen") || strings.HasPrefix(term, "tmux")
+
+	switch {
+	case colorTerm == "truecolor" || colorTerm == "24bit":
+		terminal.ColorDepth = TrueColor
+	case strings.Contains(term, "256color"):
+		terminal.ColorDepth = Colors256
+	}
+
+	// emojis need a UTF-8 locale, the first one set wins
+	for _, name := range []string{"LC_ALL", "LC_CTYPE", "LANG"} {
+		if locale := os.Getenv(name); locale != "" {
+			locale = strings.ToUpper(locale)
+			terminal.EmojiSupport = strings.Contains(locale, "UTF-8") || strings.Contains(locale, "UTF8")
+			break
+		}
+	}
+}
+
+// isTerminal is isatty - tells if the file is a terminal.
+func isTerminal(file *os.File) bool {
+	_, err := unix.IoctlGetTermios(int(file.Fd()), ioctlReadTermios)
+	return err == nil
+}
@@ -0,0 +1,89 @@
+//go:build linux || darwin || freebsd || netbsd || openbsd || dragonfly
+
+package outputhandler
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestParseProcStat(t *testing.T) {
+
+	cases := map[string]ProcessInfo{
+		"1234 (bash) S 1200 1234 1234 34816":      {"bash", 1234, 1200},
+		"42 (tmux: server) S 1 42 42 0 -1":        {"tmux: server", 42, 1},
+		"77 (odd) name)) R 76 77 77 0 -1 4194560": {"odd) name)", 77, 76},
+	}
+
+	for stat, expected := range cases {
+		procInfo, err := parseProcStat(stat)
+		if err != nil {
+			t.Errorf("%q: %v", stat, err)
+			continue
+		}
+		if procInfo != expected {
+			t.Errorf("%q: expected %+v, got %+v", stat, expected, procInfo)
+		}
+	}
+
+	for _, stat := range []string{"", "1234 bash S 1200", "x (bash) S 1200", "1234 (bash) S"} {
+		if _, err := parseProcStat(stat); err == nil {
+			t.Errorf("%q: expected error", stat)
+		}
+	}
+}
+
+func TestReadParentChain(t *testing.T) {
+
+	// 30 <- 20 <- 10 <- 1, with an unrelated broken process
+	procDir := t.TempDir()
+	for pid, stat := range map[string]string{
+		"1":  "1 (init) S 0 1 1 0",
+		"10": "10 (konsole) S 1 10 10 0",
+		"20": "20 (bash) S 10 20 20 34816",
+		"30": "30 (go) S 20 30 20 34816",
+		"99": "garbage",
+		"50": "50 (orphan) S 40 50 50 0",
+	} {
+		if err := os.MkdirAll(filepath.Join(procDir, pid), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(filepath.Join(procDir, pid, "stat"), []byte(stat), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	chain := readParentChain(procDir, 30)
+	if len(chain) != 4 || chain[1].ExeName != "init" || chain[10].ExeName != "konsole" || chain[30].ParentPID != 20 {
+		t.Errorf("unexpected chain %v", chain)
+	}
+
+	// the chain just ends at a missing or broken one
+	if chain := readParentChain(procDir, 50); len(chain) != 1 {
+		t.Errorf("unexpected chain %v", chain)
+	}
+	if chain := readParentChain(procDir, 99); len(chain) != 0 {
+		t.Errorf("unexpected chain %v", chain)
+	}
+}
+
+func TestGetProcesses(t *testing.T) {
+
+	processes, err := GetProcesses()
+	if errors.Is(err, ErrNoProcessList) {
+		t.Skip("no /proc here")
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	parent, ok := (*processes)[uint32(os.Getppid())]
+	if !ok {
+		t.Fatal("the parent of the test process is not in the list")
+	}
+	if parent.ProcessId != uint32(os.Getppid()) {
+		t.Errorf("expected pid %d, got %d", os.Getppid(), parent.ProcessId)
+	}
+}
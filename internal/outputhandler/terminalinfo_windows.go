@@ -0,0 +1,105 @@
+package outputhandler
+
+import (
+	"fmt"
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+var knowEnvironments = []RunningEnvironment{
+	{
+		Name:                 "File Explorer",
+		ExeName:              "explorer.exe",
+		AddsCSICursorSupport: false,
+		AddsCSIColorSupport:  false,
+		AddsEmojiSupport:     false,
+		AddsColorDepth:       Colors16,
+	},
+	{
+		Name:                 "VS Code",
+		ExeName:              "Code.exe",
+		AddsCSICursorSupport: true,
+		AddsCSIColorSupport:  true,
+		AddsEmojiSupport:     true,
+		AddsColorDepth:       TrueColor,
+	},
+}
+
+var knownTerminals = []TerminalInfo{
+	{ // Go debugger
+		Name:             "Delve",
+		ExeName:          "dlv.exe",
+		CSICursorSupport: false,
+		CSIColorSupport:  true,
+		EmojiSupport:     true,
+		ColorDepth:       Colors16,
+	},
+	{ // I use Git bash so...
+		Name:             "bash",
+		ExeName:          "bash.exe",
+		CSICursorSupport: true,
+		CSIColorSupport:  true,
+		EmojiSupport:     true,
+		ColorDepth:       TrueColor,
+	},
+	{
+		Name:             "Command Prompt",
+		ExeName:          "cmd.exe",
+		CSICursorSupport: true,
+		CSIColorSupport:  true,
+		EmojiSupport:     false,
+		ColorDepth:       TrueColor,
+	},
+	{
+		Name:             "PowerShell",
+		ExeName:          "powershell.exe",
+		CSICursorSupport: true,
+		CSIColorSupport:  true,
+		EmojiSupport:     false,
+		ColorDepth:       TrueColor,
+	},
+	{ // Win11 thing, no clue about this one
+		Name:             "Windows Terminal",
+		ExeName:          "wt.exe",
+		CSICursorSupport: true,
+		CSIColorSupport:  true,
+		EmojiSupport:     true,
+		ColorDepth:       TrueColor,
+	},
+}
+
+// GetProcesses enumerates all running processes - at least browsing MSDN gives the impression.
+func GetProcesses() (*map[uint32]ProcessInfo, error) {
+
+	hSnapshot, err := windows.CreateToolhelp32Snapshot(windows.TH32CS_SNAPPROCESS, 0)
+	if err != nil {
+		return nil, fmt.Errorf("error in CreateToolhelp32Snapshot: %w", err)
+	}
+	defer windows.CloseHandle(hSnapshot)
+
+	pe := windows.ProcessEntry32{}
+	pe.Size = uint32(unsafe.Sizeof(pe))
+
+	processInfo := make(map[uint32]ProcessInfo, 0)
+	for {
+
+		exeName := windows.UTF16ToString(pe.ExeFile[:])
+		processInfo[pe.ProcessID] = ProcessInfo{
+			ExeName:   exeName,
+			ProcessId: pe.ProcessID,
+			ParentPID: pe.ParentProcessID,
+		}
+
+		err := windows.Process32Next(hSnapshot, &pe)
+		if err == windows.ERROR_NO_MORE_FILES {
+			return &processInfo, nil
+		} else if err != nil {
+			return nil, fmt.Errorf("error in Process32Next: %w", err)
+		}
+	}
+}
+
+// detectFromEnvironment has nothing to add on Windows,
+// the known terminals are all there is.
+func detectFromEnvironment(terminal *TerminalInfo) {}
@@ -0,0 +1,46 @@
+package solution
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+)
+
+var registry = map[int]Solution{}
+
+// Register adds the solution of a day, so the runner can find it by number.
+// Meant to be called from the init function of the day's package.
+// Panics if the day is already registered.
+func Register(day int, s Solution) {
+	if _, ok := registry[day]; ok {
+		panic(fmt.Sprintf("solution: day %d registered twice", day))
+	}
+	registry[day] = s
+}
+
+// Get returns the solution registered for the day.
+func Get(day int) (Solution, bool) {
+	s, ok := registry[day]
+	return s, ok
+}
+
+// Days returns the registered days in order.
+func Days() []int {
+	days := make([]int, 0, len(registry))
+	for day := range registry {
+		days = append(days, day)
+	}
+	slices.Sort(days)
+	return days
+}
+
+// DayOf returns the day the solution is registered for, or false if it's not.
+// The solutions are matched by type, every day has its own.
+func DayOf(s Solution) (int, bool) {
+	for day, registered := range registry {
+		if reflect.TypeOf(registered) == reflect.TypeOf(s) {
+			return day, true
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,118 @@
+// solution package provides the common frame for the daily solutions,
+// so they only have to implement the two parts of the puzzle.
+//
+// The parts are run separately - only the one asked for on the commandline
+// or both - and timed one by one. Parts not done yet can return
+// ErrNotImplemented and they are skipped.
+package solution
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/rawbits2010/AoC25/internal/inputhandler"
+)
+
+// ErrNotImplemented is returned by the parts still being worked on.
+var ErrNotImplemented = errors.New("not implemented yet")
+
+// Solution is a solver for one day of the puzzles.
+// Both parts get the whole input, and return the answer or an error.
+// The answer can be anything printable with %v.
+type Solution interface {
+	Part1(lines []string) (any, error)
+	Part2(lines []string) (any, error)
+}
+
+// Result of running one part of a Solution.
+type Result struct {
+	Part     int
+	Value    any
+	Err      error
+	Duration time.Duration
+}
+
+// Skipped reports whether the part is not implemented yet.
+func (r Result) Skipped() bool {
+	return errors.Is(r.Err, ErrNotImplemented)
+}
+
+// String returns the answer, or why there is none.
+func (r Result) String() string {
+	switch {
+	case r.Skipped():
+		return "not implemented"
+	case r.Err != nil:
+		return "error"
+	default:
+		return fmt.Sprint(r.Value)
+	}
+}
+
+// RunPart runs and times a single part of the solution.
+func RunPart(s Solution, lines []string, part int) Result {
+
+	result := Result{Part: part}
+
+	start := time.Now()
+	switch part {
+	case 1:
+		result.Value, result.Err = s.Part1(lines)
+	case 2:
+		result.Value, result.Err = s.Part2(lines)
+	default:
+		result.Err = fmt.Errorf("there is no part %d", part)
+	}
+	result.Duration = time.Since(start)
+
+	return result
+}
+
+// Run runs the given part of the solution, or both if part is 0.
+func Run(s Solution, lines []string, part int) []Result {
+
+	if part != 0 {
+		return []Result{RunPart(s, lines, part)}
+	}
+
+	return []Result{RunPart(s, lines, 1), RunPart(s, lines, 2)}
+}
+
+// Main reads the input and runs the part of the solution set on the
+// commandline. Prints the answers with the time taken, or exits on error.
+func Main(s Solution) {
+
+	// 0 if not registered, then '--example' looks in the current directory
+	day, _ := DayOf(s)
+	lines := inputhandler.ReadDayInput(day)
+
+	results := Run(s, lines, inputhandler.Part())
+
+	for _, result := range results {
+		if result.Err != nil && !result.Skipped() {
+			log.Fatalf("error in part %d: %s", result.Part, result.Err)
+		}
+	}
+
+	printLine("Result", results, Result.String)
+	printLine("Time", results, func(r Result) string { return r.Duration.String() })
+}
+
+// printLine prints a value of every part in one line, like:
+//
+//	Result - Part 1: 123, Part 2: 456
+func printLine(label string, results []Result, value func(Result) string) {
+
+	fmt.Print(label)
+	for i, result := range results {
+		if i == 0 {
+			fmt.Print(" - ")
+		} else {
+			fmt.Print(", ")
+		}
+		fmt.Printf("Part %d: %s", result.Part, value(result))
+	}
+	fmt.Println()
+}
@@ -0,0 +1,59 @@
+package solution
+
+import (
+	"errors"
+	"testing"
+)
+
+type testSolution struct{}
+
+func (testSolution) Part1(lines []string) (any, error) {
+	return len(lines), nil
+}
+
+func (testSolution) Part2(lines []string) (any, error) {
+	return nil, ErrNotImplemented
+}
+
+func TestRun(t *testing.T) {
+
+	lines := []string{"a", "b", "c"}
+
+	results := Run(testSolution{}, lines, 0)
+	if len(results) != 2 {
+		t.Fatalf("expected both parts, got %d results", len(results))
+	}
+	if results[0].Part != 1 || results[0].Err != nil || results[0].String() != "3" {
+		t.Errorf("part 1: got %+v", results[0])
+	}
+	if results[1].Part != 2 || !results[1].Skipped() {
+		t.Errorf("part 2: expected skipped, got %+v", results[1])
+	}
+
+	results = Run(testSolution{}, lines, 1)
+	if len(results) != 1 || results[0].Part != 1 {
+		t.Errorf("expected only part 1, got %+v", results)
+	}
+
+	result := RunPart(testSolution{}, lines, 3)
+	if result.Err == nil || errors.Is(result.Err, ErrNotImplemented) {
+		t.Errorf("expected error for part 3, got %v", result.Err)
+	}
+}
+
+func TestDayOf(t *testing.T) {
+
+	Register(99, testSolution{})
+	t.Cleanup(func() {
+		delete(registry, 99)
+	})
+
+	if day, ok := DayOf(testSolution{}); !ok || day != 99 {
+		t.Errorf("expected day 99, got %d %v", day, ok)
+	}
+
+	type otherSolution struct{ testSolution }
+	if _, ok := DayOf(otherSolution{}); ok {
+		t.Error("expected an unregistered solution not to be found")
+	}
+}
@@ -0,0 +1,118 @@
+// solutiontest package runs the solutions over the puzzle examples,
+// for the tests of the days.
+//
+// The examples are in the testdata directory of the test, named like
+// example1.txt, and their answers are in testdata/answers.txt:
+//
+//	# example part1 part2
+//	example1.txt 3 6
+//	example2.txt - 14
+//
+// Lines starting with '#' are comments, and '-' is for the part the
+// example is not for.
+package solutiontest
+
+import (
+	"fmt"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/rawbits2010/AoC25/internal/answers"
+	"github.com/rawbits2010/AoC25/internal/inputhandler"
+	"github.com/rawbits2010/AoC25/internal/solution"
+)
+
+// Dir is where the examples and their answers are.
+const Dir = "testdata"
+
+// AnswersFile is the name of the answers file in Dir.
+const AnswersFile = "answers.txt"
+
+// NoAnswer is for the part the example is not for.
+const NoAnswer = answers.Unknown
+
+// Example is a puzzle example with the answers of the two parts.
+type Example struct {
+	Name    string
+	Answers [2]string
+}
+
+// RunExamples runs both parts of the solution on every example
+// in the testdata directory, and checks the answers.
+// The solution sees the '--example' option set while running.
+func RunExamples(t *testing.T, s solution.Solution) {
+	t.Helper()
+
+	examples, err := LoadExamples(Dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(examples) == 0 {
+		t.Fatalf("no examples in %s", Dir)
+	}
+
+	inputhandler.Options.Set("example", "true")
+	t.Cleanup(func() {
+		inputhandler.Options.Set("example", "false")
+	})
+
+	for _, example := range examples {
+		t.Run(strings.TrimSuffix(example.Name, ".txt"), func(t *testing.T) {
+
+			lines, err := inputhandler.Read(inputhandler.Config{
+				Method: inputhandler.InputFile,
+				Value:  filepath.Join(Dir, example.Name),
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			for part := 1; part <= 2; part++ {
+
+				expected := example.Answers[part-1]
+				if expected == NoAnswer {
+					continue
+				}
+
+				result := solution.RunPart(s, lines, part)
+				if result.Err != nil {
+					t.Errorf("part %d: %v", part, result.Err)
+					continue
+				}
+				if result.String() != expected {
+					t.Errorf("part %d: expected %s, got %s", part, expected, result)
+				}
+			}
+		})
+	}
+}
+
+// LoadExamples reads the answers file in the directory. It's an error
+// if there is an example file in the directory without answers.
+func LoadExamples(dir string) ([]Example, error) {
+
+	answersList, err := answers.LoadExamples(filepath.Join(dir, AnswersFile))
+	if err != nil {
+		return nil, err
+	}
+
+	examples := make([]Example, len(answersList))
+	for i, answer := range answersList {
+		examples[i] = Example{Name: answer.Name, Answers: answer.Parts}
+	}
+
+	files, err := filepath.Glob(filepath.Join(dir, "example*.txt"))
+	if err != nil {
+		return nil, err
+	}
+	for _, path := range files {
+		name := filepath.Base(path)
+		if !slices.ContainsFunc(examples, func(e Example) bool { return e.Name == name }) {
+			return nil, fmt.Errorf("no answers for %s in %s", name, AnswersFile)
+		}
+	}
+
+	return examples, nil
+}